@@ -0,0 +1,459 @@
+package cqlc
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gocql/gocql"
+	"reflect"
+	"strings"
+)
+
+const (
+	defaultBatchStatements = 100
+	// Cassandra rejects batches above batch_size_fail_threshold_in_kb, which defaults to 50KB
+	defaultBatchBytes = 50 * 1024
+)
+
+var (
+	ErrMixedBatch                 = errors.New("Cannot batch counter and non-counter statements together")
+	ErrBatchTimestamp             = errors.New("Custom timestamps cannot be used with counter or conditional batches")
+	ErrConditionalBatch           = errors.New("Conditional batches must be executed with Swap")
+	ErrConditionalBatchPartitions = errors.New("Conditional batches must write to a single partition")
+)
+
+type batchStatement struct {
+	stmt         string
+	placeHolders []interface{}
+	partition    string
+	size         int
+	casBindings  []ColumnBinding
+}
+
+type batchChunk struct {
+	batchType  gocql.BatchType
+	statements []batchStatement
+}
+
+// BatchError reports the failure of a single chunk of a BatchBuilder or a single partition of a BulkWriter.
+type BatchError struct {
+	// Chunk is the index of the failed chunk in the order the chunks were executed
+	Chunk int
+	// Partition identifies the partition of a failed BulkWriter batch
+	Partition string
+	// Statements is the number of statements that the failed chunk contained
+	Statements int
+	Err        error
+}
+
+func (e *BatchError) Error() string {
+	if e.Partition != "" {
+		return fmt.Sprintf("Batch for partition %s (%d statements) failed: %v", e.Partition, e.Statements, e.Err)
+	}
+	return fmt.Sprintf("Batch chunk %d (%d statements) failed: %v", e.Chunk, e.Statements, e.Err)
+}
+
+// BatchErrors is returned when one or more batches could not be executed.
+type BatchErrors []*BatchError
+
+func (e BatchErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// BatchBuilder collects statements and executes them as one or more batches.
+// The type of each batch is chosen automatically: counter statements are sent as COUNTER batches,
+// statements that all write to the same partition are sent UNLOGGED and anything else is LOGGED.
+// Statements are split into chunks once either the statement count or the estimated size in bytes
+// of a chunk would exceed its limit.
+type BatchBuilder struct {
+	statements    []batchStatement
+	counter       bool
+	conditional   bool
+	timestamp     int64
+	hasTimestamp  bool
+	maxStatements int
+	maxBytes      int
+	// Debug flag will cause all CQL statements to get logged
+	Debug bool
+}
+
+// NewBatchBuilder creates an empty batch builder with the default chunk limits.
+func NewBatchBuilder() *BatchBuilder {
+	return &BatchBuilder{
+		maxStatements: defaultBatchStatements,
+		maxBytes:      defaultBatchBytes,
+	}
+}
+
+// MaxStatements sets the maximum number of statements in a single batch.
+func (b *BatchBuilder) MaxStatements(n int) *BatchBuilder {
+	b.maxStatements = n
+	return b
+}
+
+// MaxBytes sets the maximum estimated size of a single batch.
+// A statement that is larger than the limit on its own is sent in a batch by itself.
+func (b *BatchBuilder) MaxBytes(n int) *BatchBuilder {
+	b.maxBytes = n
+	return b
+}
+
+// Timestamp sets the write time, in microseconds since the epoch, of every statement in the batch,
+// in the same way as a USING TIMESTAMP clause on the batch would.
+// Cassandra does not allow custom timestamps on counter or conditional batches.
+// The timestamp only applies until the builder is next executed.
+func (b *BatchBuilder) Timestamp(ts int64) *BatchBuilder {
+	b.timestamp = ts
+	b.hasTimestamp = true
+	return b
+}
+
+// Len returns the number of statements that are waiting to be executed.
+func (b *BatchBuilder) Len() int {
+	return len(b.statements)
+}
+
+// AddTo adds the statement to a batch builder.
+// Counter statements cannot be added to a builder that contains non-counter statements and vice versa.
+func (c *Context) AddTo(b *BatchBuilder) error {
+	counter := isCounterStatement(c)
+	if len(b.statements) > 0 && counter != b.counter {
+		c.Dispose()
+		return ErrMixedBatch
+	}
+
+	partition := statementPartition(c)
+	casBindings := c.CASBindings
+
+	stmt, placeHolders, err := BuildStatement(c)
+	if err != nil {
+		return err
+	}
+
+	if c.Debug {
+		debugStmt(stmt, placeHolders)
+	}
+
+	b.counter = counter
+	b.conditional = b.conditional || len(casBindings) > 0
+	b.statements = append(b.statements, batchStatement{
+		stmt:         stmt,
+		placeHolders: placeHolders,
+		partition:    partition,
+		size:         statementSize(stmt, placeHolders),
+		casBindings:  casBindings,
+	})
+
+	return nil
+}
+
+// Exec executes every chunk of the builder in turn, continuing past chunks that fail.
+// If any of the chunks fail, the errors are returned as BatchErrors.
+// The builder is empty afterwards and can be reused.
+func (b *BatchBuilder) Exec(s *gocql.Session) error {
+	if b.conditional {
+		return ErrConditionalBatch
+	}
+	if b.counter && b.hasTimestamp {
+		return ErrBatchTimestamp
+	}
+
+	var errs BatchErrors
+
+	for i, chunk := range b.chunks() {
+		if err := s.ExecuteBatch(b.batch(chunk)); err != nil {
+			errs = append(errs, &BatchError{Chunk: i, Statements: len(chunk.statements), Err: err})
+		}
+	}
+
+	b.reset()
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// Swap executes the builder as a single conditional batch, which Cassandra only permits
+// when all of its statements write to the same partition, so the batch is never split.
+// Returns true if the conditions were met and the batch was applied, false otherwise.
+// If the batch was not applied, the bindings passed to IfExists are populated with the current row.
+func (b *BatchBuilder) Swap(s *gocql.Session) (bool, error) {
+	if !b.conditional {
+		return false, ErrCASBindings
+	}
+	if b.hasTimestamp {
+		return false, ErrBatchTimestamp
+	}
+
+	chunk := b.chunk(b.statements)
+	if chunk.batchType != gocql.UnloggedBatch {
+		return false, ErrConditionalBatchPartitions
+	}
+
+	row := make(map[string]interface{})
+	applied, iter, err := s.MapExecuteBatchCAS(b.batch(chunk), row)
+	if iter != nil {
+		iter.Close()
+	}
+
+	statements := b.statements
+	b.reset()
+
+	if err != nil {
+		return false, err
+	}
+
+	if !applied {
+		for _, st := range statements {
+			for _, binding := range st.casBindings {
+				if err := assignBinding(binding, row); err != nil {
+					return false, err
+				}
+			}
+		}
+	}
+
+	return applied, nil
+}
+
+func (b *BatchBuilder) batch(chunk batchChunk) *gocql.Batch {
+	batch := gocql.NewBatch(chunk.batchType)
+	for _, st := range chunk.statements {
+		batch.Query(st.stmt, st.placeHolders...)
+	}
+	if b.hasTimestamp {
+		batch.WithTimestamp(b.timestamp)
+	}
+	return batch
+}
+
+func (b *BatchBuilder) reset() {
+	b.statements = nil
+	b.counter = false
+	b.conditional = false
+	b.timestamp = 0
+	b.hasTimestamp = false
+}
+
+func (b *BatchBuilder) chunks() []batchChunk {
+	chunks := make([]batchChunk, 0)
+	for _, statements := range splitStatements(b.statements, b.maxStatements, b.maxBytes) {
+		chunks = append(chunks, b.chunk(statements))
+	}
+	return chunks
+}
+
+// splitStatements splits statements into consecutive runs that stay within the statement count
+// and estimated size limits, where a limit of zero or less means unlimited.
+func splitStatements(statements []batchStatement, maxStatements, maxBytes int) [][]batchStatement {
+	runs := make([][]batchStatement, 0)
+	current := make([]batchStatement, 0)
+	size := 0
+
+	for _, st := range statements {
+		full := maxStatements > 0 && len(current) >= maxStatements
+		large := maxBytes > 0 && size+st.size > maxBytes
+		if len(current) > 0 && (full || large) {
+			runs = append(runs, current)
+			current = make([]batchStatement, 0)
+			size = 0
+		}
+		current = append(current, st)
+		size += st.size
+	}
+
+	if len(current) > 0 {
+		runs = append(runs, current)
+	}
+
+	return runs
+}
+
+func (b *BatchBuilder) chunk(statements []batchStatement) batchChunk {
+	if b.counter {
+		return batchChunk{batchType: gocql.CounterBatch, statements: statements}
+	}
+
+	partition := statements[0].partition
+	for _, st := range statements {
+		if st.partition == "" || st.partition != partition {
+			return batchChunk{batchType: gocql.LoggedBatch, statements: statements}
+		}
+	}
+
+	return batchChunk{batchType: gocql.UnloggedBatch, statements: statements}
+}
+
+// assignBinding copies the value of the binding's column from a result row into the binding's target.
+// The row holds the default Go types of gocql, which are converted to the type of the target,
+// so that e.g. an int can be assigned to an int32 field or to a field with a custom string type.
+func assignBinding(binding ColumnBinding, row map[string]interface{}) error {
+	value, ok := row[binding.Column.ColumnName()]
+	if !ok {
+		return nil
+	}
+
+	target := reflect.ValueOf(binding.Value)
+	if target.Kind() != reflect.Ptr || target.IsNil() {
+		return bindingErrorf("Cannot bind component: %+v (type: %s)", binding.Value, reflect.TypeOf(binding.Value))
+	}
+
+	v := reflect.ValueOf(value)
+	if !v.IsValid() {
+		return nil
+	}
+
+	return assignValue(target.Elem(), v)
+}
+
+// assignValue sets dest to v, allocating dest if it is a pointer to the type of v.
+func assignValue(dest, v reflect.Value) error {
+	switch {
+	case v.Type().AssignableTo(dest.Type()):
+		dest.Set(v)
+	case isConvertible(v.Type(), dest.Type()):
+		dest.Set(v.Convert(dest.Type()))
+	case dest.Kind() == reflect.Ptr:
+		elem := reflect.New(dest.Type().Elem())
+		if err := assignValue(elem.Elem(), v); err != nil {
+			return err
+		}
+		dest.Set(elem)
+	default:
+		return bindingErrorf("Cannot bind %s value to %s", v.Type(), dest.Type())
+	}
+	return nil
+}
+
+// isConvertible reports whether a value can be converted without changing its meaning,
+// which rules out the conversion of integers to strings of a single rune.
+func isConvertible(from, to reflect.Type) bool {
+	if !from.ConvertibleTo(to) {
+		return false
+	}
+	if to.Kind() == reflect.String {
+		return from.Kind() == reflect.String || (from.Kind() == reflect.Slice && from.Elem().Kind() == reflect.Uint8)
+	}
+	return true
+}
+
+func isCounterStatement(c *Context) bool {
+	if c.Operation == CounterOperation {
+		return true
+	}
+	ct, ok := c.Table.(CounterTable)
+	return ok && ct.IsCounterTable()
+}
+
+// statementPartition identifies the partition that a statement writes to,
+// or returns an empty string if the full partition key is not restricted to single values.
+func statementPartition(c *Context) string {
+	if c.Table == nil {
+		return ""
+	}
+
+	bindings := make([]ColumnBinding, 0, len(c.Bindings)+len(c.Conditions))
+	for _, cond := range c.Conditions {
+		if cond.Predicate == EqPredicate {
+			bindings = append(bindings, cond.Binding)
+		}
+	}
+	if !c.hasConditions() {
+		bindings = append(bindings, c.Bindings...)
+	}
+
+	return partitionOf(c.Table, bindings)
+}
+
+// partitionOf renders the values bound to the partition key columns of a table as a string,
+// which is empty if any of the partition key columns is not bound.
+func partitionOf(t Table, bindings []ColumnBinding) string {
+	keys, err := partitionKeyColumns(t)
+	if err != nil {
+		return ""
+	}
+
+	values := make([]string, len(keys))
+	for i, key := range keys {
+		found := false
+		for _, binding := range bindings {
+			if binding.Column.ColumnName() == key.ColumnName() {
+				values[i] = fmt.Sprintf("%#v", dereference(binding.Value))
+				found = true
+				break
+			}
+		}
+		if !found {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("%s.%s(%s)", t.Keyspace(), t.TableName(), strings.Join(values, ","))
+}
+
+// dereference follows pointers to the value they point to, so that equal values bound
+// through different pointers belong to the same partition.
+func dereference(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+	return rv.Interface()
+}
+
+// statementSize approximates the number of bytes that a statement and its bound values occupy on the wire.
+func statementSize(stmt string, placeHolders []interface{}) int {
+	size := len(stmt)
+	for _, v := range placeHolders {
+		size += estimateSize(v)
+	}
+	return size
+}
+
+// estimateSize approximates the number of bytes that a bound value occupies on the wire.
+func estimateSize(v interface{}) int {
+	switch v := v.(type) {
+	case nil:
+		return 0
+	case string:
+		return len(v)
+	case []byte:
+		return len(v)
+	case gocql.UUID:
+		return 16
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return 0
+		}
+		return estimateSize(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		size := 0
+		for i := 0; i < rv.Len(); i++ {
+			size += estimateSize(rv.Index(i).Interface())
+		}
+		return size
+	case reflect.Map:
+		size := 0
+		for _, k := range rv.MapKeys() {
+			size += estimateSize(k.Interface()) + estimateSize(rv.MapIndex(k).Interface())
+		}
+		return size
+	}
+
+	return 8
+}
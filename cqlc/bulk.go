@@ -0,0 +1,170 @@
+package cqlc
+
+import (
+	"errors"
+	"github.com/gocql/gocql"
+	"sync"
+)
+
+const (
+	defaultBulkParallelism = 4
+)
+
+var (
+	ErrUnboundPartitionKey = errors.New("Table binding does not bind every partition key column")
+	ErrBulkCounter         = errors.New("Counter tables cannot be written by a bulk writer")
+)
+
+type partitionGroup struct {
+	partition  string
+	statements []batchStatement
+}
+
+// BulkWriter stores table bindings by grouping them by partition key and sending
+// UNLOGGED batches per partition, so that each batch is applied by a single replica set.
+// The statements of a partition are split into several batches with the same limits as a BatchBuilder.
+type BulkWriter struct {
+	parallelism   int
+	maxStatements int
+	maxBytes      int
+	// Debug flag will cause all CQL statements to get logged
+	Debug bool
+}
+
+// NewBulkWriter creates a bulk writer with the default parallelism and batch limits.
+func NewBulkWriter() *BulkWriter {
+	return &BulkWriter{
+		parallelism:   defaultBulkParallelism,
+		maxStatements: defaultBatchStatements,
+		maxBytes:      defaultBatchBytes,
+	}
+}
+
+// Parallelism sets the maximum number of partition batches that are in flight at the same time.
+func (w *BulkWriter) Parallelism(n int) *BulkWriter {
+	w.parallelism = n
+	return w
+}
+
+// MaxStatements sets the maximum number of statements in a single batch.
+func (w *BulkWriter) MaxStatements(n int) *BulkWriter {
+	w.maxStatements = n
+	return w
+}
+
+// MaxBytes sets the maximum estimated size of a single batch.
+// A statement that is larger than the limit on its own is sent in a batch by itself.
+func (w *BulkWriter) MaxBytes(n int) *BulkWriter {
+	w.maxBytes = n
+	return w
+}
+
+// Write stores each of the bindings, which will typically be created by the generated <Table>Def.Bind method.
+// Every binding needs to bind the full partition key of its table, and counter tables are not supported.
+// All batches are written even if some of them fail, in which case the failures are returned
+// as BatchErrors, identified by their partition.
+func (w *BulkWriter) Write(session *gocql.Session, bindings ...TableBinding) error {
+
+	groups, err := w.group(bindings)
+	if err != nil {
+		return err
+	}
+
+	batches := w.split(groups)
+
+	parallelism := w.parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	sem := make(chan struct{}, parallelism)
+	failures := make(chan *BatchError, len(batches))
+
+	var wg sync.WaitGroup
+
+	for i, g := range batches {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, g partitionGroup) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			batch := gocql.NewBatch(gocql.UnloggedBatch)
+			for _, st := range g.statements {
+				batch.Query(st.stmt, st.placeHolders...)
+			}
+
+			if err := session.ExecuteBatch(batch); err != nil {
+				failures <- &BatchError{Chunk: i, Partition: g.partition, Statements: len(g.statements), Err: err}
+			}
+		}(i, g)
+	}
+
+	wg.Wait()
+	close(failures)
+
+	var errs BatchErrors
+	for f := range failures {
+		errs = append(errs, f)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// group renders the bindings as upserts, grouped by partition in the order in which
+// each partition is first encountered.
+func (w *BulkWriter) group(bindings []TableBinding) ([]partitionGroup, error) {
+	groups := make([]partitionGroup, 0)
+	index := make(map[string]int)
+
+	ctx := NewContext()
+
+	for _, b := range bindings {
+		if ct, ok := b.Table.(CounterTable); ok && ct.IsCounterTable() {
+			return nil, ErrBulkCounter
+		}
+
+		partition := partitionOf(b.Table, b.Columns)
+		if partition == "" {
+			return nil, ErrUnboundPartitionKey
+		}
+
+		ctx.Store(b)
+		stmt, placeHolders, err := BuildStatement(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if w.Debug {
+			debugStmt(stmt, placeHolders)
+		}
+
+		st := batchStatement{stmt: stmt, placeHolders: placeHolders, partition: partition, size: statementSize(stmt, placeHolders)}
+
+		i, ok := index[partition]
+		if !ok {
+			i = len(groups)
+			index[partition] = i
+			groups = append(groups, partitionGroup{partition: partition})
+		}
+		groups[i].statements = append(groups[i].statements, st)
+	}
+
+	return groups, nil
+}
+
+// split divides the statements of each partition into batches that stay within the limits of the writer.
+func (w *BulkWriter) split(groups []partitionGroup) []partitionGroup {
+	batches := make([]partitionGroup, 0, len(groups))
+	for _, g := range groups {
+		for _, statements := range splitStatements(g.statements, w.maxStatements, w.maxBytes) {
+			batches = append(batches, partitionGroup{partition: g.partition, statements: statements})
+		}
+	}
+	return batches
+}
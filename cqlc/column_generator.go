@@ -7,6 +7,7 @@ import (
 	"fmt"
 	log "github.com/cihub/seelog"
 	"io/ioutil"
+	"strings"
 	"text/template"
 )
 
@@ -34,28 +35,33 @@ func init() {
 type TypeInfo struct {
 	Prefix  string
 	Literal string
+	Cql     string
 }
 
 var types = []TypeInfo{
-	TypeInfo{Prefix: "String", Literal: "string"},
-	TypeInfo{Prefix: "Int32", Literal: "int32"},
-	TypeInfo{Prefix: "Int64", Literal: "int64"},
-	TypeInfo{Prefix: "Float32", Literal: "float32"},
-	TypeInfo{Prefix: "Float64", Literal: "float64"},
-	TypeInfo{Prefix: "Timestamp", Literal: "time.Time"},
-	TypeInfo{Prefix: "TimeUUID", Literal: "gocql.UUID"},
-	TypeInfo{Prefix: "UUID", Literal: "gocql.UUID"},
-	TypeInfo{Prefix: "Boolean", Literal: "bool"},
-	TypeInfo{Prefix: "Decimal", Literal: "*inf.Dec"},
-	TypeInfo{Prefix: "Varint", Literal: "*big.Int"},
-	TypeInfo{Prefix: "Bytes", Literal: "[]byte"},
+	TypeInfo{Prefix: "String", Literal: "string", Cql: "text"},
+	TypeInfo{Prefix: "Int32", Literal: "int32", Cql: "int"},
+	TypeInfo{Prefix: "Int64", Literal: "int64", Cql: "bigint"},
+	TypeInfo{Prefix: "Float32", Literal: "float32", Cql: "float"},
+	TypeInfo{Prefix: "Float64", Literal: "float64", Cql: "double"},
+	TypeInfo{Prefix: "Timestamp", Literal: "time.Time", Cql: "timestamp"},
+	TypeInfo{Prefix: "TimeUUID", Literal: "gocql.UUID", Cql: "timeuuid"},
+	TypeInfo{Prefix: "UUID", Literal: "gocql.UUID", Cql: "uuid"},
+	TypeInfo{Prefix: "Boolean", Literal: "bool", Cql: "boolean"},
+	TypeInfo{Prefix: "Decimal", Literal: "*inf.Dec", Cql: "decimal"},
+	TypeInfo{Prefix: "Varint", Literal: "*big.Int", Cql: "varint"},
+	TypeInfo{Prefix: "Bytes", Literal: "[]byte", Cql: "blob"},
 }
 
 func main() {
 	params := make(map[string]interface{})
 	params["types"] = types
 
-	t, err := template.New("columns.tmpl").ParseFiles("tmpl/columns.tmpl")
+	m := template.FuncMap{
+		"title": strings.Title,
+	}
+
+	t, err := template.New("columns.tmpl").Funcs(m).ParseFiles("tmpl/columns.tmpl")
 	if err != nil {
 		log.Errorf("Could not open template: %s", err)
 		return
@@ -3,6 +3,7 @@
 package cqlc
 
 import (
+	"fmt"
 	"github.com/gocql/gocql"
 	"time"
 	"math/big"
@@ -21,6 +22,20 @@ type EqualityStringColumn interface {
 	Eq(value string) Condition
 }
 
+type FilterableStringColumn interface {
+	StringColumn
+	Eq(value string) FilterCondition
+	Gt(value string) FilterCondition
+	Lt(value string) FilterCondition
+	Ge(value string) FilterCondition
+	Le(value string) FilterCondition
+}
+
+type StaticStringColumn interface {
+	StaticColumn
+	StringColumn
+}
+
 type PartitionedStringColumn interface {
 	PartitionedColumn
 	EqualityStringColumn
@@ -38,6 +53,7 @@ type ClusteredStringColumn interface {
 	Lt(value string) Condition
 	Ge(value string) Condition
 	Le(value string) Condition
+	
 }
 
 type LastClusteredStringColumn interface {
@@ -57,6 +73,20 @@ type EqualityInt32Column interface {
 	Eq(value int32) Condition
 }
 
+type FilterableInt32Column interface {
+	Int32Column
+	Eq(value int32) FilterCondition
+	Gt(value int32) FilterCondition
+	Lt(value int32) FilterCondition
+	Ge(value int32) FilterCondition
+	Le(value int32) FilterCondition
+}
+
+type StaticInt32Column interface {
+	StaticColumn
+	Int32Column
+}
+
 type PartitionedInt32Column interface {
 	PartitionedColumn
 	EqualityInt32Column
@@ -74,6 +104,7 @@ type ClusteredInt32Column interface {
 	Lt(value int32) Condition
 	Ge(value int32) Condition
 	Le(value int32) Condition
+	
 }
 
 type LastClusteredInt32Column interface {
@@ -93,6 +124,20 @@ type EqualityInt64Column interface {
 	Eq(value int64) Condition
 }
 
+type FilterableInt64Column interface {
+	Int64Column
+	Eq(value int64) FilterCondition
+	Gt(value int64) FilterCondition
+	Lt(value int64) FilterCondition
+	Ge(value int64) FilterCondition
+	Le(value int64) FilterCondition
+}
+
+type StaticInt64Column interface {
+	StaticColumn
+	Int64Column
+}
+
 type PartitionedInt64Column interface {
 	PartitionedColumn
 	EqualityInt64Column
@@ -110,6 +155,7 @@ type ClusteredInt64Column interface {
 	Lt(value int64) Condition
 	Ge(value int64) Condition
 	Le(value int64) Condition
+	
 }
 
 type LastClusteredInt64Column interface {
@@ -129,6 +175,20 @@ type EqualityFloat32Column interface {
 	Eq(value float32) Condition
 }
 
+type FilterableFloat32Column interface {
+	Float32Column
+	Eq(value float32) FilterCondition
+	Gt(value float32) FilterCondition
+	Lt(value float32) FilterCondition
+	Ge(value float32) FilterCondition
+	Le(value float32) FilterCondition
+}
+
+type StaticFloat32Column interface {
+	StaticColumn
+	Float32Column
+}
+
 type PartitionedFloat32Column interface {
 	PartitionedColumn
 	EqualityFloat32Column
@@ -146,6 +206,7 @@ type ClusteredFloat32Column interface {
 	Lt(value float32) Condition
 	Ge(value float32) Condition
 	Le(value float32) Condition
+	
 }
 
 type LastClusteredFloat32Column interface {
@@ -165,6 +226,20 @@ type EqualityFloat64Column interface {
 	Eq(value float64) Condition
 }
 
+type FilterableFloat64Column interface {
+	Float64Column
+	Eq(value float64) FilterCondition
+	Gt(value float64) FilterCondition
+	Lt(value float64) FilterCondition
+	Ge(value float64) FilterCondition
+	Le(value float64) FilterCondition
+}
+
+type StaticFloat64Column interface {
+	StaticColumn
+	Float64Column
+}
+
 type PartitionedFloat64Column interface {
 	PartitionedColumn
 	EqualityFloat64Column
@@ -182,6 +257,7 @@ type ClusteredFloat64Column interface {
 	Lt(value float64) Condition
 	Ge(value float64) Condition
 	Le(value float64) Condition
+	
 }
 
 type LastClusteredFloat64Column interface {
@@ -201,6 +277,20 @@ type EqualityTimestampColumn interface {
 	Eq(value time.Time) Condition
 }
 
+type FilterableTimestampColumn interface {
+	TimestampColumn
+	Eq(value time.Time) FilterCondition
+	Gt(value time.Time) FilterCondition
+	Lt(value time.Time) FilterCondition
+	Ge(value time.Time) FilterCondition
+	Le(value time.Time) FilterCondition
+}
+
+type StaticTimestampColumn interface {
+	StaticColumn
+	TimestampColumn
+}
+
 type PartitionedTimestampColumn interface {
 	PartitionedColumn
 	EqualityTimestampColumn
@@ -218,6 +308,7 @@ type ClusteredTimestampColumn interface {
 	Lt(value time.Time) Condition
 	Ge(value time.Time) Condition
 	Le(value time.Time) Condition
+	
 }
 
 type LastClusteredTimestampColumn interface {
@@ -237,6 +328,20 @@ type EqualityTimeUUIDColumn interface {
 	Eq(value gocql.UUID) Condition
 }
 
+type FilterableTimeUUIDColumn interface {
+	TimeUUIDColumn
+	Eq(value gocql.UUID) FilterCondition
+	Gt(value gocql.UUID) FilterCondition
+	Lt(value gocql.UUID) FilterCondition
+	Ge(value gocql.UUID) FilterCondition
+	Le(value gocql.UUID) FilterCondition
+}
+
+type StaticTimeUUIDColumn interface {
+	StaticColumn
+	TimeUUIDColumn
+}
+
 type PartitionedTimeUUIDColumn interface {
 	PartitionedColumn
 	EqualityTimeUUIDColumn
@@ -254,6 +359,14 @@ type ClusteredTimeUUIDColumn interface {
 	Lt(value gocql.UUID) Condition
 	Ge(value gocql.UUID) Condition
 	Le(value gocql.UUID) Condition
+	
+	// After restricts the column to timeuuids generated after the given time
+	After(value time.Time) Condition
+	// Before restricts the column to timeuuids generated before the given time
+	Before(value time.Time) Condition
+	// Between restricts the column to timeuuids generated within the given times, inclusively
+	Between(from, to time.Time) Condition
+	
 }
 
 type LastClusteredTimeUUIDColumn interface {
@@ -273,6 +386,20 @@ type EqualityUUIDColumn interface {
 	Eq(value gocql.UUID) Condition
 }
 
+type FilterableUUIDColumn interface {
+	UUIDColumn
+	Eq(value gocql.UUID) FilterCondition
+	Gt(value gocql.UUID) FilterCondition
+	Lt(value gocql.UUID) FilterCondition
+	Ge(value gocql.UUID) FilterCondition
+	Le(value gocql.UUID) FilterCondition
+}
+
+type StaticUUIDColumn interface {
+	StaticColumn
+	UUIDColumn
+}
+
 type PartitionedUUIDColumn interface {
 	PartitionedColumn
 	EqualityUUIDColumn
@@ -290,6 +417,7 @@ type ClusteredUUIDColumn interface {
 	Lt(value gocql.UUID) Condition
 	Ge(value gocql.UUID) Condition
 	Le(value gocql.UUID) Condition
+	
 }
 
 type LastClusteredUUIDColumn interface {
@@ -309,6 +437,20 @@ type EqualityBooleanColumn interface {
 	Eq(value bool) Condition
 }
 
+type FilterableBooleanColumn interface {
+	BooleanColumn
+	Eq(value bool) FilterCondition
+	Gt(value bool) FilterCondition
+	Lt(value bool) FilterCondition
+	Ge(value bool) FilterCondition
+	Le(value bool) FilterCondition
+}
+
+type StaticBooleanColumn interface {
+	StaticColumn
+	BooleanColumn
+}
+
 type PartitionedBooleanColumn interface {
 	PartitionedColumn
 	EqualityBooleanColumn
@@ -326,6 +468,7 @@ type ClusteredBooleanColumn interface {
 	Lt(value bool) Condition
 	Ge(value bool) Condition
 	Le(value bool) Condition
+	
 }
 
 type LastClusteredBooleanColumn interface {
@@ -345,6 +488,20 @@ type EqualityDecimalColumn interface {
 	Eq(value *inf.Dec) Condition
 }
 
+type FilterableDecimalColumn interface {
+	DecimalColumn
+	Eq(value *inf.Dec) FilterCondition
+	Gt(value *inf.Dec) FilterCondition
+	Lt(value *inf.Dec) FilterCondition
+	Ge(value *inf.Dec) FilterCondition
+	Le(value *inf.Dec) FilterCondition
+}
+
+type StaticDecimalColumn interface {
+	StaticColumn
+	DecimalColumn
+}
+
 type PartitionedDecimalColumn interface {
 	PartitionedColumn
 	EqualityDecimalColumn
@@ -362,6 +519,7 @@ type ClusteredDecimalColumn interface {
 	Lt(value *inf.Dec) Condition
 	Ge(value *inf.Dec) Condition
 	Le(value *inf.Dec) Condition
+	
 }
 
 type LastClusteredDecimalColumn interface {
@@ -381,6 +539,20 @@ type EqualityVarintColumn interface {
 	Eq(value *big.Int) Condition
 }
 
+type FilterableVarintColumn interface {
+	VarintColumn
+	Eq(value *big.Int) FilterCondition
+	Gt(value *big.Int) FilterCondition
+	Lt(value *big.Int) FilterCondition
+	Ge(value *big.Int) FilterCondition
+	Le(value *big.Int) FilterCondition
+}
+
+type StaticVarintColumn interface {
+	StaticColumn
+	VarintColumn
+}
+
 type PartitionedVarintColumn interface {
 	PartitionedColumn
 	EqualityVarintColumn
@@ -398,6 +570,7 @@ type ClusteredVarintColumn interface {
 	Lt(value *big.Int) Condition
 	Ge(value *big.Int) Condition
 	Le(value *big.Int) Condition
+	
 }
 
 type LastClusteredVarintColumn interface {
@@ -417,6 +590,20 @@ type EqualityBytesColumn interface {
 	Eq(value []byte) Condition
 }
 
+type FilterableBytesColumn interface {
+	BytesColumn
+	Eq(value []byte) FilterCondition
+	Gt(value []byte) FilterCondition
+	Lt(value []byte) FilterCondition
+	Ge(value []byte) FilterCondition
+	Le(value []byte) FilterCondition
+}
+
+type StaticBytesColumn interface {
+	StaticColumn
+	BytesColumn
+}
+
 type PartitionedBytesColumn interface {
 	PartitionedColumn
 	EqualityBytesColumn
@@ -434,6 +621,7 @@ type ClusteredBytesColumn interface {
 	Lt(value []byte) Condition
 	Ge(value []byte) Condition
 	Le(value []byte) Condition
+	
 }
 
 type LastClusteredBytesColumn interface {
@@ -444,6 +632,471 @@ type LastClusteredBytesColumn interface {
 
 
 
+// StringSelector is an expression in a select clause that yields values of type string.
+type StringSelector struct {
+	expression string
+	alias      string
+}
+
+func (s *StringSelector) ColumnName() string {
+	return selectorName(s.expression, s.alias)
+}
+
+func (s *StringSelector) Expression() string {
+	return selectorExpression(s.expression, s.alias)
+}
+
+// As names the result of the selector, which allows it to be bound with To.
+func (s *StringSelector) As(alias string) *StringSelector {
+	return &StringSelector{expression: s.expression, alias: alias}
+}
+
+func (s *StringSelector) To(value *string) ColumnBinding {
+	return ColumnBinding{Column: s, Value: value}
+}
+
+// AliasString selects a column under a different name.
+func AliasString(col StringColumn, alias string) *StringSelector {
+	return &StringSelector{expression: col.ColumnName(), alias: alias}
+}
+
+// CastAsString converts a column to text using the CQL CAST function.
+func CastAsString(col Column) *StringSelector {
+	return &StringSelector{expression: fmt.Sprintf("CAST(%s AS text)", col.ColumnName())}
+}
+
+// BlobAsString converts a blob column to text using the CQL blobAsText function.
+func BlobAsString(col BytesColumn) *StringSelector {
+	return &StringSelector{expression: fmt.Sprintf("blobAsText(%s)", col.ColumnName())}
+}
+
+
+// Int32Selector is an expression in a select clause that yields values of type int32.
+type Int32Selector struct {
+	expression string
+	alias      string
+}
+
+func (s *Int32Selector) ColumnName() string {
+	return selectorName(s.expression, s.alias)
+}
+
+func (s *Int32Selector) Expression() string {
+	return selectorExpression(s.expression, s.alias)
+}
+
+// As names the result of the selector, which allows it to be bound with To.
+func (s *Int32Selector) As(alias string) *Int32Selector {
+	return &Int32Selector{expression: s.expression, alias: alias}
+}
+
+func (s *Int32Selector) To(value *int32) ColumnBinding {
+	return ColumnBinding{Column: s, Value: value}
+}
+
+// AliasInt32 selects a column under a different name.
+func AliasInt32(col Int32Column, alias string) *Int32Selector {
+	return &Int32Selector{expression: col.ColumnName(), alias: alias}
+}
+
+// CastAsInt32 converts a column to int using the CQL CAST function.
+func CastAsInt32(col Column) *Int32Selector {
+	return &Int32Selector{expression: fmt.Sprintf("CAST(%s AS int)", col.ColumnName())}
+}
+
+// BlobAsInt32 converts a blob column to int using the CQL blobAsInt function.
+func BlobAsInt32(col BytesColumn) *Int32Selector {
+	return &Int32Selector{expression: fmt.Sprintf("blobAsInt(%s)", col.ColumnName())}
+}
+
+
+// Int64Selector is an expression in a select clause that yields values of type int64.
+type Int64Selector struct {
+	expression string
+	alias      string
+}
+
+func (s *Int64Selector) ColumnName() string {
+	return selectorName(s.expression, s.alias)
+}
+
+func (s *Int64Selector) Expression() string {
+	return selectorExpression(s.expression, s.alias)
+}
+
+// As names the result of the selector, which allows it to be bound with To.
+func (s *Int64Selector) As(alias string) *Int64Selector {
+	return &Int64Selector{expression: s.expression, alias: alias}
+}
+
+func (s *Int64Selector) To(value *int64) ColumnBinding {
+	return ColumnBinding{Column: s, Value: value}
+}
+
+// AliasInt64 selects a column under a different name.
+func AliasInt64(col Int64Column, alias string) *Int64Selector {
+	return &Int64Selector{expression: col.ColumnName(), alias: alias}
+}
+
+// CastAsInt64 converts a column to bigint using the CQL CAST function.
+func CastAsInt64(col Column) *Int64Selector {
+	return &Int64Selector{expression: fmt.Sprintf("CAST(%s AS bigint)", col.ColumnName())}
+}
+
+// BlobAsInt64 converts a blob column to bigint using the CQL blobAsBigint function.
+func BlobAsInt64(col BytesColumn) *Int64Selector {
+	return &Int64Selector{expression: fmt.Sprintf("blobAsBigint(%s)", col.ColumnName())}
+}
+
+
+// Float32Selector is an expression in a select clause that yields values of type float32.
+type Float32Selector struct {
+	expression string
+	alias      string
+}
+
+func (s *Float32Selector) ColumnName() string {
+	return selectorName(s.expression, s.alias)
+}
+
+func (s *Float32Selector) Expression() string {
+	return selectorExpression(s.expression, s.alias)
+}
+
+// As names the result of the selector, which allows it to be bound with To.
+func (s *Float32Selector) As(alias string) *Float32Selector {
+	return &Float32Selector{expression: s.expression, alias: alias}
+}
+
+func (s *Float32Selector) To(value *float32) ColumnBinding {
+	return ColumnBinding{Column: s, Value: value}
+}
+
+// AliasFloat32 selects a column under a different name.
+func AliasFloat32(col Float32Column, alias string) *Float32Selector {
+	return &Float32Selector{expression: col.ColumnName(), alias: alias}
+}
+
+// CastAsFloat32 converts a column to float using the CQL CAST function.
+func CastAsFloat32(col Column) *Float32Selector {
+	return &Float32Selector{expression: fmt.Sprintf("CAST(%s AS float)", col.ColumnName())}
+}
+
+// BlobAsFloat32 converts a blob column to float using the CQL blobAsFloat function.
+func BlobAsFloat32(col BytesColumn) *Float32Selector {
+	return &Float32Selector{expression: fmt.Sprintf("blobAsFloat(%s)", col.ColumnName())}
+}
+
+
+// Float64Selector is an expression in a select clause that yields values of type float64.
+type Float64Selector struct {
+	expression string
+	alias      string
+}
+
+func (s *Float64Selector) ColumnName() string {
+	return selectorName(s.expression, s.alias)
+}
+
+func (s *Float64Selector) Expression() string {
+	return selectorExpression(s.expression, s.alias)
+}
+
+// As names the result of the selector, which allows it to be bound with To.
+func (s *Float64Selector) As(alias string) *Float64Selector {
+	return &Float64Selector{expression: s.expression, alias: alias}
+}
+
+func (s *Float64Selector) To(value *float64) ColumnBinding {
+	return ColumnBinding{Column: s, Value: value}
+}
+
+// AliasFloat64 selects a column under a different name.
+func AliasFloat64(col Float64Column, alias string) *Float64Selector {
+	return &Float64Selector{expression: col.ColumnName(), alias: alias}
+}
+
+// CastAsFloat64 converts a column to double using the CQL CAST function.
+func CastAsFloat64(col Column) *Float64Selector {
+	return &Float64Selector{expression: fmt.Sprintf("CAST(%s AS double)", col.ColumnName())}
+}
+
+// BlobAsFloat64 converts a blob column to double using the CQL blobAsDouble function.
+func BlobAsFloat64(col BytesColumn) *Float64Selector {
+	return &Float64Selector{expression: fmt.Sprintf("blobAsDouble(%s)", col.ColumnName())}
+}
+
+
+// TimestampSelector is an expression in a select clause that yields values of type time.Time.
+type TimestampSelector struct {
+	expression string
+	alias      string
+}
+
+func (s *TimestampSelector) ColumnName() string {
+	return selectorName(s.expression, s.alias)
+}
+
+func (s *TimestampSelector) Expression() string {
+	return selectorExpression(s.expression, s.alias)
+}
+
+// As names the result of the selector, which allows it to be bound with To.
+func (s *TimestampSelector) As(alias string) *TimestampSelector {
+	return &TimestampSelector{expression: s.expression, alias: alias}
+}
+
+func (s *TimestampSelector) To(value *time.Time) ColumnBinding {
+	return ColumnBinding{Column: s, Value: value}
+}
+
+// AliasTimestamp selects a column under a different name.
+func AliasTimestamp(col TimestampColumn, alias string) *TimestampSelector {
+	return &TimestampSelector{expression: col.ColumnName(), alias: alias}
+}
+
+// CastAsTimestamp converts a column to timestamp using the CQL CAST function.
+func CastAsTimestamp(col Column) *TimestampSelector {
+	return &TimestampSelector{expression: fmt.Sprintf("CAST(%s AS timestamp)", col.ColumnName())}
+}
+
+// BlobAsTimestamp converts a blob column to timestamp using the CQL blobAsTimestamp function.
+func BlobAsTimestamp(col BytesColumn) *TimestampSelector {
+	return &TimestampSelector{expression: fmt.Sprintf("blobAsTimestamp(%s)", col.ColumnName())}
+}
+
+
+// TimeUUIDSelector is an expression in a select clause that yields values of type gocql.UUID.
+type TimeUUIDSelector struct {
+	expression string
+	alias      string
+}
+
+func (s *TimeUUIDSelector) ColumnName() string {
+	return selectorName(s.expression, s.alias)
+}
+
+func (s *TimeUUIDSelector) Expression() string {
+	return selectorExpression(s.expression, s.alias)
+}
+
+// As names the result of the selector, which allows it to be bound with To.
+func (s *TimeUUIDSelector) As(alias string) *TimeUUIDSelector {
+	return &TimeUUIDSelector{expression: s.expression, alias: alias}
+}
+
+func (s *TimeUUIDSelector) To(value *gocql.UUID) ColumnBinding {
+	return ColumnBinding{Column: s, Value: value}
+}
+
+// AliasTimeUUID selects a column under a different name.
+func AliasTimeUUID(col TimeUUIDColumn, alias string) *TimeUUIDSelector {
+	return &TimeUUIDSelector{expression: col.ColumnName(), alias: alias}
+}
+
+// CastAsTimeUUID converts a column to timeuuid using the CQL CAST function.
+func CastAsTimeUUID(col Column) *TimeUUIDSelector {
+	return &TimeUUIDSelector{expression: fmt.Sprintf("CAST(%s AS timeuuid)", col.ColumnName())}
+}
+
+// BlobAsTimeUUID converts a blob column to timeuuid using the CQL blobAsTimeuuid function.
+func BlobAsTimeUUID(col BytesColumn) *TimeUUIDSelector {
+	return &TimeUUIDSelector{expression: fmt.Sprintf("blobAsTimeuuid(%s)", col.ColumnName())}
+}
+
+
+// UUIDSelector is an expression in a select clause that yields values of type gocql.UUID.
+type UUIDSelector struct {
+	expression string
+	alias      string
+}
+
+func (s *UUIDSelector) ColumnName() string {
+	return selectorName(s.expression, s.alias)
+}
+
+func (s *UUIDSelector) Expression() string {
+	return selectorExpression(s.expression, s.alias)
+}
+
+// As names the result of the selector, which allows it to be bound with To.
+func (s *UUIDSelector) As(alias string) *UUIDSelector {
+	return &UUIDSelector{expression: s.expression, alias: alias}
+}
+
+func (s *UUIDSelector) To(value *gocql.UUID) ColumnBinding {
+	return ColumnBinding{Column: s, Value: value}
+}
+
+// AliasUUID selects a column under a different name.
+func AliasUUID(col UUIDColumn, alias string) *UUIDSelector {
+	return &UUIDSelector{expression: col.ColumnName(), alias: alias}
+}
+
+// CastAsUUID converts a column to uuid using the CQL CAST function.
+func CastAsUUID(col Column) *UUIDSelector {
+	return &UUIDSelector{expression: fmt.Sprintf("CAST(%s AS uuid)", col.ColumnName())}
+}
+
+// BlobAsUUID converts a blob column to uuid using the CQL blobAsUuid function.
+func BlobAsUUID(col BytesColumn) *UUIDSelector {
+	return &UUIDSelector{expression: fmt.Sprintf("blobAsUuid(%s)", col.ColumnName())}
+}
+
+
+// BooleanSelector is an expression in a select clause that yields values of type bool.
+type BooleanSelector struct {
+	expression string
+	alias      string
+}
+
+func (s *BooleanSelector) ColumnName() string {
+	return selectorName(s.expression, s.alias)
+}
+
+func (s *BooleanSelector) Expression() string {
+	return selectorExpression(s.expression, s.alias)
+}
+
+// As names the result of the selector, which allows it to be bound with To.
+func (s *BooleanSelector) As(alias string) *BooleanSelector {
+	return &BooleanSelector{expression: s.expression, alias: alias}
+}
+
+func (s *BooleanSelector) To(value *bool) ColumnBinding {
+	return ColumnBinding{Column: s, Value: value}
+}
+
+// AliasBoolean selects a column under a different name.
+func AliasBoolean(col BooleanColumn, alias string) *BooleanSelector {
+	return &BooleanSelector{expression: col.ColumnName(), alias: alias}
+}
+
+// CastAsBoolean converts a column to boolean using the CQL CAST function.
+func CastAsBoolean(col Column) *BooleanSelector {
+	return &BooleanSelector{expression: fmt.Sprintf("CAST(%s AS boolean)", col.ColumnName())}
+}
+
+// BlobAsBoolean converts a blob column to boolean using the CQL blobAsBoolean function.
+func BlobAsBoolean(col BytesColumn) *BooleanSelector {
+	return &BooleanSelector{expression: fmt.Sprintf("blobAsBoolean(%s)", col.ColumnName())}
+}
+
+
+// DecimalSelector is an expression in a select clause that yields values of type *inf.Dec.
+type DecimalSelector struct {
+	expression string
+	alias      string
+}
+
+func (s *DecimalSelector) ColumnName() string {
+	return selectorName(s.expression, s.alias)
+}
+
+func (s *DecimalSelector) Expression() string {
+	return selectorExpression(s.expression, s.alias)
+}
+
+// As names the result of the selector, which allows it to be bound with To.
+func (s *DecimalSelector) As(alias string) *DecimalSelector {
+	return &DecimalSelector{expression: s.expression, alias: alias}
+}
+
+func (s *DecimalSelector) To(value **inf.Dec) ColumnBinding {
+	return ColumnBinding{Column: s, Value: value}
+}
+
+// AliasDecimal selects a column under a different name.
+func AliasDecimal(col DecimalColumn, alias string) *DecimalSelector {
+	return &DecimalSelector{expression: col.ColumnName(), alias: alias}
+}
+
+// CastAsDecimal converts a column to decimal using the CQL CAST function.
+func CastAsDecimal(col Column) *DecimalSelector {
+	return &DecimalSelector{expression: fmt.Sprintf("CAST(%s AS decimal)", col.ColumnName())}
+}
+
+// BlobAsDecimal converts a blob column to decimal using the CQL blobAsDecimal function.
+func BlobAsDecimal(col BytesColumn) *DecimalSelector {
+	return &DecimalSelector{expression: fmt.Sprintf("blobAsDecimal(%s)", col.ColumnName())}
+}
+
+
+// VarintSelector is an expression in a select clause that yields values of type *big.Int.
+type VarintSelector struct {
+	expression string
+	alias      string
+}
+
+func (s *VarintSelector) ColumnName() string {
+	return selectorName(s.expression, s.alias)
+}
+
+func (s *VarintSelector) Expression() string {
+	return selectorExpression(s.expression, s.alias)
+}
+
+// As names the result of the selector, which allows it to be bound with To.
+func (s *VarintSelector) As(alias string) *VarintSelector {
+	return &VarintSelector{expression: s.expression, alias: alias}
+}
+
+func (s *VarintSelector) To(value **big.Int) ColumnBinding {
+	return ColumnBinding{Column: s, Value: value}
+}
+
+// AliasVarint selects a column under a different name.
+func AliasVarint(col VarintColumn, alias string) *VarintSelector {
+	return &VarintSelector{expression: col.ColumnName(), alias: alias}
+}
+
+// CastAsVarint converts a column to varint using the CQL CAST function.
+func CastAsVarint(col Column) *VarintSelector {
+	return &VarintSelector{expression: fmt.Sprintf("CAST(%s AS varint)", col.ColumnName())}
+}
+
+// BlobAsVarint converts a blob column to varint using the CQL blobAsVarint function.
+func BlobAsVarint(col BytesColumn) *VarintSelector {
+	return &VarintSelector{expression: fmt.Sprintf("blobAsVarint(%s)", col.ColumnName())}
+}
+
+
+// BytesSelector is an expression in a select clause that yields values of type []byte.
+type BytesSelector struct {
+	expression string
+	alias      string
+}
+
+func (s *BytesSelector) ColumnName() string {
+	return selectorName(s.expression, s.alias)
+}
+
+func (s *BytesSelector) Expression() string {
+	return selectorExpression(s.expression, s.alias)
+}
+
+// As names the result of the selector, which allows it to be bound with To.
+func (s *BytesSelector) As(alias string) *BytesSelector {
+	return &BytesSelector{expression: s.expression, alias: alias}
+}
+
+func (s *BytesSelector) To(value *[]byte) ColumnBinding {
+	return ColumnBinding{Column: s, Value: value}
+}
+
+// AliasBytes selects a column under a different name.
+func AliasBytes(col BytesColumn, alias string) *BytesSelector {
+	return &BytesSelector{expression: col.ColumnName(), alias: alias}
+}
+
+// CastAsBytes converts a column to blob using the CQL CAST function.
+func CastAsBytes(col Column) *BytesSelector {
+	return &BytesSelector{expression: fmt.Sprintf("CAST(%s AS blob)", col.ColumnName())}
+}
+
+
+
+
 type StringSliceColumn interface {
 	ListColumn
 	To(value *[]string) ColumnBinding
@@ -1200,6 +1853,11 @@ type SetValueStep interface {
 	Apply(cols ...ColumnBinding) SetValueStep
 	IfExists(cols ...ColumnBinding) CompareAndSwap
 
+	// These set a column to the result of a function that is evaluated by the coordinator
+	SetTimeUUIDFunction(col TimeUUIDColumn, value TimeUUIDFunction) SetValueStep
+	SetUUIDFunction(col UUIDColumn, value UUIDFunction) SetValueStep
+	SetTimestampFunction(col TimestampColumn, value TimestampFunction) SetValueStep
+
 	
 	SetString(col StringColumn, value string) SetValueStep
 	
@@ -1711,6 +2369,97 @@ type SetValueStep interface {
 	
 }
 
+type UpdateStaticStep interface {
+	StaticWhereStep
+	
+	SetStaticString(col StaticStringColumn, value string) UpdateStaticStep
+	
+	SetStaticInt32(col StaticInt32Column, value int32) UpdateStaticStep
+	
+	SetStaticInt64(col StaticInt64Column, value int64) UpdateStaticStep
+	
+	SetStaticFloat32(col StaticFloat32Column, value float32) UpdateStaticStep
+	
+	SetStaticFloat64(col StaticFloat64Column, value float64) UpdateStaticStep
+	
+	SetStaticTimestamp(col StaticTimestampColumn, value time.Time) UpdateStaticStep
+	
+	SetStaticTimeUUID(col StaticTimeUUIDColumn, value gocql.UUID) UpdateStaticStep
+	
+	SetStaticUUID(col StaticUUIDColumn, value gocql.UUID) UpdateStaticStep
+	
+	SetStaticBoolean(col StaticBooleanColumn, value bool) UpdateStaticStep
+	
+	SetStaticDecimal(col StaticDecimalColumn, value *inf.Dec) UpdateStaticStep
+	
+	SetStaticVarint(col StaticVarintColumn, value *big.Int) UpdateStaticStep
+	
+	SetStaticBytes(col StaticBytesColumn, value []byte) UpdateStaticStep
+	
+}
+
+
+func (c *Context) SetStaticString(col StaticStringColumn, value string) UpdateStaticStep {
+	set(c, col, value)
+	return c
+}
+
+func (c *Context) SetStaticInt32(col StaticInt32Column, value int32) UpdateStaticStep {
+	set(c, col, value)
+	return c
+}
+
+func (c *Context) SetStaticInt64(col StaticInt64Column, value int64) UpdateStaticStep {
+	set(c, col, value)
+	return c
+}
+
+func (c *Context) SetStaticFloat32(col StaticFloat32Column, value float32) UpdateStaticStep {
+	set(c, col, value)
+	return c
+}
+
+func (c *Context) SetStaticFloat64(col StaticFloat64Column, value float64) UpdateStaticStep {
+	set(c, col, value)
+	return c
+}
+
+func (c *Context) SetStaticTimestamp(col StaticTimestampColumn, value time.Time) UpdateStaticStep {
+	set(c, col, value)
+	return c
+}
+
+func (c *Context) SetStaticTimeUUID(col StaticTimeUUIDColumn, value gocql.UUID) UpdateStaticStep {
+	set(c, col, value)
+	return c
+}
+
+func (c *Context) SetStaticUUID(col StaticUUIDColumn, value gocql.UUID) UpdateStaticStep {
+	set(c, col, value)
+	return c
+}
+
+func (c *Context) SetStaticBoolean(col StaticBooleanColumn, value bool) UpdateStaticStep {
+	set(c, col, value)
+	return c
+}
+
+func (c *Context) SetStaticDecimal(col StaticDecimalColumn, value *inf.Dec) UpdateStaticStep {
+	set(c, col, value)
+	return c
+}
+
+func (c *Context) SetStaticVarint(col StaticVarintColumn, value *big.Int) UpdateStaticStep {
+	set(c, col, value)
+	return c
+}
+
+func (c *Context) SetStaticBytes(col StaticBytesColumn, value []byte) UpdateStaticStep {
+	set(c, col, value)
+	return c
+}
+
+
 
 
 
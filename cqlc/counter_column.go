@@ -5,3 +5,12 @@ type CounterColumn interface {
 	CanIncrement() bool
 	To(value *int64) ColumnBinding
 }
+
+// CounterDelta binds the change of a counter column, so that a negative delta is
+// rendered as a decrement by its magnitude rather than an increment by a negative value.
+func CounterDelta(col CounterColumn, delta int64) ColumnBinding {
+	if delta < 0 {
+		return ColumnBinding{Column: col, Value: -delta, CollectionOperationType: Subtract}
+	}
+	return ColumnBinding{Column: col, Value: delta}
+}
@@ -35,6 +35,8 @@ const (
 	LtPredicate
 	LePredicate
 	InPredicate
+	// BetweenPredicate restricts a column to an inclusive range of two values
+	BetweenPredicate
 )
 
 const (
@@ -43,6 +45,9 @@ const (
 	WriteOperation
 	DeleteOperation
 	CounterOperation
+	JsonInsertOperation
+	// StaticUpdateOperation writes only the static columns of a partition
+	StaticUpdateOperation
 )
 
 const (
@@ -57,10 +62,14 @@ const (
 	Append
 	Prepend
 	RemoveByValue
+	// Subtract decrements a counter column by the bound value
+	Subtract
 )
 
 var (
-	ErrCASBindings = errors.New("Invalid CAS bindings")
+	ErrCASBindings        = errors.New("Invalid CAS bindings")
+	ErrEmptyCounterUpdate = errors.New("No counter columns to update")
+	ErrReadOnlyView       = errors.New("Materialized views cannot be written to")
 )
 
 type OrderSpec struct {
@@ -69,9 +78,13 @@ type OrderSpec struct {
 }
 
 type ReadOptions struct {
-	Distinct bool
-	Limit    int
-	Ordering []OrderSpec
+	Distinct          bool
+	Limit             int
+	PerPartitionLimit int
+	Ordering          []OrderSpec
+	Grouping          []string
+	AllowFiltering    bool
+	Json              bool
 }
 
 // Context represents the state of the CQL statement that is being built by the application.
@@ -90,6 +103,8 @@ type Context struct {
 	Keyspace string
 	// Setting StaticKeyspace to true will cause the generated CQL to be qualified by the keyspace the code was generated against.
 	StaticKeyspace bool
+	// JsonDefault determines how columns that are omitted from an INSERT JSON document are written.
+	JsonDefault JsonDefaultType
 }
 
 func defaultReadOptions() *ReadOptions {
@@ -105,10 +120,12 @@ func NewContext() *Context {
 type Executable interface {
 	Exec(*gocql.Session) error
 	Batch(*gocql.Batch) error
+	AddTo(*BatchBuilder) error
 }
 
 type CompareAndSwap interface {
 	Swap(*gocql.Session) (bool, error)
+	AddTo(*BatchBuilder) error
 }
 
 type Fetchable interface {
@@ -129,22 +146,46 @@ type Query interface {
 	Fetchable
 	// OrderBy sets the ordering of the returned query
 	OrderBy(col ...ClusteredColumn) Fetchable
+	// AllowFiltering appends ALLOW FILTERING to the query and adds the supplied
+	// filter predicates on regular columns to the WHERE clause.
+	AllowFiltering(filters ...FilterCondition) Query
+	// GroupBy groups the result rows by a prefix of the primary key,
+	// which needs to include every partition key column.
+	GroupBy(cols ...Column) Query
+	// PerPartitionLimit constrains the number of rows returned from each partition
+	PerPartitionLimit(limit int) Query
 }
 
 type SelectWhereStep interface {
 	Fetchable
 	Where(conditions ...Condition) Query
+	// AllowFiltering appends ALLOW FILTERING to the query and adds the supplied
+	// filter predicates on regular columns to the WHERE clause.
+	AllowFiltering(filters ...FilterCondition) Query
+	// GroupBy groups the result rows by a prefix of the primary key,
+	// which needs to include every partition key column.
+	GroupBy(cols ...Column) Query
+	// PerPartitionLimit constrains the number of rows returned from each partition
+	PerPartitionLimit(limit int) Query
 }
 
 type SelectFromStep interface {
 	From(table Table) SelectWhereStep
 }
 
+type SelectDistinctStep interface {
+	SelectFromStep
+	// Static adds static columns to a SELECT DISTINCT statement.
+	Static(cols ...StaticColumn) SelectFromStep
+}
+
 type SelectSelectStep interface {
 	Select(cols ...Column) SelectFromStep
 	// Builds a SELECT DISTINCT statement in CQL - this operation can only be used
-	// with a partitioned column.
-	SelectDistinct(col PartitionedColumn) SelectFromStep
+	// with the partition key columns and optionally any static columns.
+	SelectDistinct(cols ...PartitionedColumn) SelectDistinctStep
+	// Builds a SELECT JSON statement in CQL, which returns each row as a single JSON document.
+	SelectJson(cols ...Column) SelectFromStep
 }
 
 type IncrementWhereStep interface {
@@ -154,6 +195,12 @@ type IncrementWhereStep interface {
 type IncrementCounterStep interface {
 	IncrementWhereStep
 	Increment(col CounterColumn, value int64) IncrementCounterStep
+	Decrement(col CounterColumn, value int64) IncrementCounterStep
+}
+
+type StaticWhereStep interface {
+	// Having restricts a static update to a partition, so only partition key conditions are permitted.
+	Having(conditions ...Condition) Executable
 }
 
 type Upsertable interface {
@@ -166,6 +213,14 @@ type CounterTable interface {
 	IsCounterTable() bool
 }
 
+// View is implemented by materialized views, which can be queried like tables but cannot be written to.
+// Upserts and counter updates of a view do not compile, as views implement neither Upsertable nor CounterTable,
+// whereas a delete from a view compiles and is only rejected with ErrReadOnlyView when it is rendered.
+type View interface {
+	Table
+	IsView() bool
+}
+
 type Table interface {
 	TableName() string
 	Keyspace() string
@@ -188,6 +243,13 @@ type PartitionedColumn interface {
 	PartitionBy() Column
 }
 
+// StaticColumn is a marker interface to denote that a column is static, i.e. a single value
+// is shared by all of the rows in a partition.
+type StaticColumn interface {
+	Column
+	IsStatic() bool
+}
+
 // ClusteredColumn is a marker interface to denote that a column is clustered.
 type ClusteredColumn interface {
 	// Returns the column name that a column family is clustered with.
@@ -210,6 +272,12 @@ type Condition struct {
 	Predicate PredicateType
 }
 
+// FilterCondition is a predicate on a regular column that can only be applied
+// to a query that explicitly allows filtering.
+type FilterCondition struct {
+	Condition
+}
+
 type ColumnBinding struct {
 	Column Column
 	Value  interface{}
@@ -236,18 +304,52 @@ func (c *Context) Select(cols ...Column) SelectFromStep {
 	return c
 }
 
-func (c *Context) SelectDistinct(col PartitionedColumn) SelectFromStep {
-	c.Columns = []Column{col.PartitionBy()}
+func (c *Context) SelectDistinct(cols ...PartitionedColumn) SelectDistinctStep {
+	c.Columns = make([]Column, len(cols))
+	for i, col := range cols {
+		c.Columns[i] = col.PartitionBy()
+	}
 	c.Operation = ReadOperation
 	c.ReadOptions.Distinct = true
 	return c
 }
 
+func (c *Context) Static(cols ...StaticColumn) SelectFromStep {
+	for _, col := range cols {
+		c.Columns = append(c.Columns, col)
+	}
+	return c
+}
+
+func (c *Context) SelectJson(cols ...Column) SelectFromStep {
+	c.Columns = cols
+	c.Operation = ReadOperation
+	c.ReadOptions.Json = true
+	return c
+}
+
 func (c *Context) Limit(lim int) Fetchable {
 	c.ReadOptions.Limit = lim
 	return c
 }
 
+func (c *Context) PerPartitionLimit(lim int) Query {
+	c.ReadOptions.PerPartitionLimit = lim
+	return c
+}
+
+func (c *Context) GroupBy(cols ...Column) Query {
+
+	grouping := make([]string, len(cols))
+	for i, col := range cols {
+		grouping[i] = col.ColumnName()
+	}
+
+	c.ReadOptions.Grouping = grouping
+
+	return c
+}
+
 func (c *Context) OrderBy(cols ...ClusteredColumn) Fetchable {
 
 	spec := make([]OrderSpec, len(cols))
@@ -265,12 +367,21 @@ func (c *Context) From(t Table) SelectWhereStep {
 	return c
 }
 
+// Delete builds a DELETE statement, which is rejected with ErrReadOnlyView at runtime
+// if it targets a materialized view.
 func (c *Context) Delete(cols ...Column) SelectFromStep {
 	c.Columns = cols
 	c.Operation = DeleteOperation
 	return c
 }
 
+// UpdateStatic builds an update of the static columns of a single partition.
+func (c *Context) UpdateStatic(t Upsertable) UpdateStaticStep {
+	c.Table = t
+	c.Operation = StaticUpdateOperation
+	return c
+}
+
 func (c *Context) UpdateCounter(t CounterTable) IncrementCounterStep {
 	c.Table = t
 	c.Operation = CounterOperation
@@ -282,6 +393,11 @@ func (c *Context) Increment(col CounterColumn, value int64) IncrementCounterStep
 	return c
 }
 
+func (c *Context) Decrement(col CounterColumn, value int64) IncrementCounterStep {
+	c.Bindings = append(c.Bindings, CounterDelta(col, -value))
+	return c
+}
+
 func (c *Context) Having(cond ...Condition) Executable {
 	c.Conditions = cond
 	return c
@@ -354,6 +470,17 @@ func (c *Context) Where(cond ...Condition) Query {
 	return c
 }
 
+func (c *Context) AllowFiltering(filters ...FilterCondition) Query {
+	conds := make([]Condition, 0, len(c.Conditions)+len(filters))
+	conds = append(conds, c.Conditions...)
+	for _, filter := range filters {
+		conds = append(conds, filter.Condition)
+	}
+	c.Conditions = conds
+	c.ReadOptions.AllowFiltering = true
+	return c
+}
+
 func (c *Context) Bind(cols ...ColumnBinding) UniqueFetchable {
 	c.ResultBindings = make(map[string]ColumnBinding)
 
@@ -424,6 +551,16 @@ func (c *Context) Prepare(s *gocql.Session) (*gocql.Query, error) {
 	for _, cond := range c.Conditions {
 		v := cond.Binding.Value
 
+		if _, ok := cond.Binding.Column.(*TupleColumn); ok {
+			placeHolders = append(placeHolders, tupleValues(cond)...)
+			continue
+		}
+
+		if args, ok := functionArguments(cond); ok {
+			placeHolders = append(placeHolders, args...)
+			continue
+		}
+
 		switch reflect.TypeOf(v).Kind() {
 		case reflect.Slice:
 			{
@@ -527,22 +664,19 @@ func BuildStatement(c *Context) (stmt string, placeHolders []interface{}, err er
 		return stmt, nil, err
 	}
 
-	bindings := len(c.Bindings) // TODO check whether this is nil
-	conditions := 0
-
-	if c.Conditions != nil {
-		conditions = len(c.Conditions)
-	}
-
-	placeHolders = make([]interface{}, bindings+conditions)
+	placeHolders = make([]interface{}, 0, len(c.Bindings)+len(c.Conditions))
 
-	for i, bind := range c.Bindings {
-		placeHolders[i] = bind.Value
+	for _, bind := range c.Bindings {
+		placeHolders = append(placeHolders, bindingArguments(bind)...)
 	}
 
-	if c.Conditions != nil {
-		for i, cond := range c.Conditions {
-			placeHolders[i+bindings] = cond.Binding.Value
+	for _, cond := range c.Conditions {
+		if _, ok := cond.Binding.Column.(*TupleColumn); ok {
+			placeHolders = append(placeHolders, tupleValues(cond)...)
+		} else if args, ok := functionArguments(cond); ok {
+			placeHolders = append(placeHolders, args...)
+		} else {
+			placeHolders = append(placeHolders, cond.Binding.Value)
 		}
 	}
 
@@ -556,10 +690,27 @@ func (c *Context) RenderCQL() (string, error) {
 
 	var buf bytes.Buffer
 
+	if v, ok := c.Table.(View); ok && v.IsView() && c.Operation != ReadOperation {
+		return "", ErrReadOnlyView
+	}
+
+	if err := validateTokenConditions(c); err != nil {
+		return "", err
+	}
+
 	// TODO This should be a switch
 	switch c.Operation {
 	case ReadOperation:
 		{
+			if err := validateTupleConditions(c); err != nil {
+				return "", err
+			}
+			if err := validateGrouping(c); err != nil {
+				return "", err
+			}
+			if err := validateDistinct(c); err != nil {
+				return "", err
+			}
 			renderSelect(c, &buf)
 		}
 	case WriteOperation:
@@ -574,12 +725,29 @@ func (c *Context) RenderCQL() (string, error) {
 		}
 	case CounterOperation:
 		{
+			if len(c.Bindings) == 0 {
+				return "", ErrEmptyCounterUpdate
+			}
 			renderUpdate(c, &buf, true)
 		}
+	case StaticUpdateOperation:
+		{
+			if err := validateStaticUpdate(c); err != nil {
+				return "", err
+			}
+			renderUpdate(c, &buf, false)
+		}
 	case DeleteOperation:
 		{
 			renderDelete(c, &buf)
 		}
+	case JsonInsertOperation:
+		{
+			if err := validateJsonDocument(c); err != nil {
+				return "", err
+			}
+			renderInsertJson(c, &buf)
+		}
 	default:
 		return "", fmt.Errorf("Unknown operation type: %s", c.Operation)
 	}
@@ -594,6 +762,8 @@ func (c *Context) Dispose() {
 	c.Bindings = nil
 	c.Conditions = nil
 	c.CASBindings = nil
+	c.JsonDefault = NoJsonDefault
+	c.ReadOptions = defaultReadOptions()
 }
 
 func Truncate(s *gocql.Session, t Table) error {
@@ -620,6 +790,112 @@ func removeList(c *Context, col ListColumn, values interface{}) {
 	c.Bindings = append(c.Bindings, b)
 }
 
+// validateGrouping verifies that a GROUP BY clause consists of the complete
+// partition key followed by a prefix of the clustering columns.
+func validateGrouping(c *Context) error {
+	grouping := c.ReadOptions.Grouping
+	if len(grouping) == 0 {
+		return nil
+	}
+
+	keys, err := partitionKeyColumns(c.Table)
+	if err != nil {
+		return err
+	}
+
+	primaryKey := make([]string, len(keys))
+	for i, key := range keys {
+		primaryKey[i] = key.ColumnName()
+	}
+	primaryKey = append(primaryKey, clusteringColumnNames(c.Table)...)
+
+	valid := len(grouping) >= len(keys) && len(grouping) <= len(primaryKey)
+	for i := 0; valid && i < len(grouping); i++ {
+		valid = grouping[i] == primaryKey[i]
+	}
+
+	if !valid {
+		return fmt.Errorf("Invalid GROUP BY (%s), table %s has the primary key (%s)",
+			strings.Join(grouping, ", "), c.Table.TableName(), strings.Join(primaryKey, ", "))
+	}
+
+	return nil
+}
+
+// validateDistinct ensures that a DISTINCT query selects the full partition key
+// and nothing other than static columns besides it.
+func validateDistinct(c *Context) error {
+	if !c.ReadOptions.Distinct {
+		return nil
+	}
+
+	if len(c.Columns) == 0 {
+		return fmt.Errorf("Invalid DISTINCT query, no partition key columns of table %s are selected", c.Table.TableName())
+	}
+
+	keys, err := partitionKeyColumns(c.Table)
+	if err != nil {
+		return err
+	}
+
+	selected := make(map[string]bool)
+	for _, col := range c.Columns {
+		_, partitioned := col.(PartitionedColumn)
+		_, static := col.(StaticColumn)
+		if !partitioned && !static {
+			return fmt.Errorf("Invalid DISTINCT column %s, only partition key and static columns can be selected", col.ColumnName())
+		}
+		selected[col.ColumnName()] = true
+	}
+
+	for _, key := range keys {
+		if !selected[key.ColumnName()] {
+			return fmt.Errorf("Invalid DISTINCT query, partition key column %s of table %s is not selected",
+				key.ColumnName(), c.Table.TableName())
+		}
+	}
+
+	return nil
+}
+
+// validateStaticUpdate ensures that a static update only sets static columns and
+// restricts every partition key column, and nothing else, with Eq or In.
+func validateStaticUpdate(c *Context) error {
+	if len(c.Bindings) == 0 {
+		return fmt.Errorf("Invalid static update of table %s, no static columns are set", c.Table.TableName())
+	}
+
+	for _, binding := range c.Bindings {
+		if _, ok := binding.Column.(StaticColumn); !ok {
+			return fmt.Errorf("Invalid static update, column %s is not static", binding.Column.ColumnName())
+		}
+	}
+
+	restricted := make(map[string]bool)
+	for _, cond := range c.Conditions {
+		if _, ok := cond.Binding.Column.(PartitionedColumn); !ok {
+			return fmt.Errorf("Invalid static update, column %s is not part of the partition key", cond.Binding.Column.ColumnName())
+		}
+		if cond.Predicate != EqPredicate && cond.Predicate != InPredicate {
+			return fmt.Errorf("Invalid static update, partition key column %s can only be restricted by Eq or In", cond.Binding.Column.ColumnName())
+		}
+		restricted[cond.Binding.Column.ColumnName()] = true
+	}
+
+	keys, err := partitionKeyColumns(c.Table)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if !restricted[key.ColumnName()] {
+			return fmt.Errorf("Invalid static update, partition key column %s of table %s is not restricted",
+				key.ColumnName(), c.Table.TableName())
+		}
+	}
+
+	return nil
+}
+
 func (c *Context) hasConditions() bool {
 	return len(c.Conditions) > 0
 }
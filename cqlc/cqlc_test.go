@@ -1,15 +1,23 @@
 package cqlc
 
 import (
+	"github.com/gocql/gocql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"math"
 	"testing"
+	"time"
 )
 
 type MockTable struct {
 	name     string
 	keyspace string
 	columns  []Column
+	counter  bool
+}
+
+type MockView struct {
+	MockTable
 }
 
 type MockAsciiColumn struct {
@@ -25,6 +33,18 @@ type MockCounterColumn struct {
 	name string
 }
 
+type MockStaticAsciiColumn struct {
+	name string
+}
+
+type MockBytesColumn struct {
+	name string
+}
+
+type MockTimeUUIDColumn struct {
+	name string
+}
+
 func (t *MockTable) TableName() string {
 	return t.name
 }
@@ -38,13 +58,17 @@ func (t *MockTable) SupportsUpsert() bool {
 }
 
 func (t *MockTable) IsCounterTable() bool {
-	return true
+	return t.counter
 }
 
 func (t *MockTable) ColumnDefinitions() []Column {
 	return t.columns
 }
 
+func (v *MockView) IsView() bool {
+	return true
+}
+
 func (t *MockAsciiColumn) ColumnName() string {
 	return t.name
 }
@@ -85,6 +109,14 @@ func (t *MockAsciiColumn) PartitionBy() Column {
 	return t
 }
 
+func (t *MockAsciiColumn) CQLType() string {
+	return "ascii"
+}
+
+func (t *MockInt32Column) CQLType() string {
+	return "int"
+}
+
 func (t *MockInt32Column) ClusterWith() string {
 	return t.ColumnName()
 }
@@ -122,6 +154,34 @@ func (t *MockCounterColumn) To(value *int64) ColumnBinding {
 	return ColumnBinding{}
 }
 
+func (t *MockStaticAsciiColumn) ColumnName() string {
+	return t.name
+}
+
+func (t *MockStaticAsciiColumn) IsStatic() bool {
+	return true
+}
+
+func (t *MockStaticAsciiColumn) To(value *string) ColumnBinding {
+	return ColumnBinding{}
+}
+
+func (t *MockBytesColumn) ColumnName() string {
+	return t.name
+}
+
+func (t *MockBytesColumn) To(value *[]byte) ColumnBinding {
+	return ColumnBinding{}
+}
+
+func (t *MockTimeUUIDColumn) ColumnName() string {
+	return t.name
+}
+
+func (t *MockTimeUUIDColumn) To(value *gocql.UUID) ColumnBinding {
+	return ColumnBinding{}
+}
+
 func eq(c Column, value interface{}) Condition {
 	return mockCondition(c, value, EqPredicate)
 }
@@ -298,6 +358,277 @@ func (s *CqlTestSuite) TestCounter() {
 	assert.Equal(s.T(), cql, "UPDATE foo SET cnt = cnt + ? WHERE id = ?")
 }
 
+func (s *CqlTestSuite) TestDecrement() {
+	idCol := &MockAsciiColumn{name: "id"}
+	cntCol := &MockCounterColumn{name: "cnt"}
+	c := NewContext()
+	c.UpdateCounter(s.table).Decrement(cntCol, int64(7)).Having(idCol.Eq("x"))
+	stmt, placeHolders, err := BuildStatement(c)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), stmt, "UPDATE foo SET cnt = cnt - ? WHERE id = ?")
+	assert.Equal(s.T(), placeHolders, []interface{}{int64(7), "x"})
+}
+
+func (s *CqlTestSuite) TestCounterDelta() {
+	idCol := &MockAsciiColumn{name: "id"}
+	upCol := &MockCounterColumn{name: "up"}
+	downCol := &MockCounterColumn{name: "down"}
+
+	b := TableBinding{Table: s.table, Columns: []ColumnBinding{
+		ColumnBinding{Column: idCol, Value: "x"},
+		CounterDelta(upCol, 3),
+		CounterDelta(downCol, -2),
+	}}
+
+	c := NewContext()
+	c.Add(b)
+	stmt, placeHolders, err := BuildStatement(c)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), stmt, "UPDATE foo SET up = up + ?, down = down - ? WHERE id = ?")
+	assert.Equal(s.T(), placeHolders, []interface{}{int64(3), int64(2), "x"})
+
+	c = NewContext()
+	c.Add(TableBinding{Table: s.table, Columns: []ColumnBinding{ColumnBinding{Column: idCol, Value: "x"}}})
+	_, err = c.RenderCQL()
+	assert.Equal(s.T(), err, ErrEmptyCounterUpdate)
+}
+
+func (s *CqlTestSuite) TestBatchBuilder() {
+	barCol := &MockAsciiColumn{name: "bar"}
+	quuxCol := &MockAsciiColumn{name: "quux"}
+	c := NewContext()
+	b := NewBatchBuilder()
+
+	for i := 0; i < 3; i++ {
+		err := c.Upsert(s.table).SetString(barCol, "a").SetString(quuxCol, "x").AddTo(b)
+		assert.NoError(s.T(), err)
+	}
+
+	chunks := b.chunks()
+	assert.Equal(s.T(), len(chunks), 1)
+	assert.Equal(s.T(), chunks[0].batchType, gocql.UnloggedBatch)
+	assert.Equal(s.T(), chunks[0].statements[0].stmt, "INSERT INTO foo (bar, quux) VALUES (?,?)")
+
+	err := c.Upsert(s.table).SetString(quuxCol, "y").Where(barCol.Eq("b")).AddTo(b)
+	assert.NoError(s.T(), err)
+
+	chunks = b.chunks()
+	assert.Equal(s.T(), len(chunks), 1)
+	assert.Equal(s.T(), chunks[0].batchType, gocql.LoggedBatch)
+
+	chunks = b.MaxStatements(3).chunks()
+	assert.Equal(s.T(), len(chunks), 2)
+	assert.Equal(s.T(), chunks[0].batchType, gocql.UnloggedBatch)
+	assert.Equal(s.T(), len(chunks[1].statements), 1)
+
+	chunks = b.MaxStatements(0).MaxBytes(1).chunks()
+	assert.Equal(s.T(), len(chunks), 4)
+
+	err = c.UpdateCounter(s.table).Increment(&MockCounterColumn{name: "cnt"}, 1).Having(barCol.Eq("a")).AddTo(b)
+	assert.Equal(s.T(), err, ErrMixedBatch)
+	assert.Equal(s.T(), b.Len(), 4)
+}
+
+func (s *CqlTestSuite) TestCounterBatchBuilder() {
+	barCol := &MockAsciiColumn{name: "bar"}
+	s.table.counter = true
+	c := NewContext()
+	b := NewBatchBuilder()
+
+	err := c.UpdateCounter(s.table).Increment(&MockCounterColumn{name: "cnt"}, 1).Having(barCol.Eq("a")).AddTo(b)
+	assert.NoError(s.T(), err)
+
+	chunks := b.chunks()
+	assert.Equal(s.T(), len(chunks), 1)
+	assert.Equal(s.T(), chunks[0].batchType, gocql.CounterBatch)
+
+	err = c.Delete().From(&MockTable{name: "baz"}).Where(barCol.Eq("a")).AddTo(b)
+	assert.Equal(s.T(), err, ErrMixedBatch)
+}
+
+func (s *CqlTestSuite) TestPartitionOfPointers() {
+	barCol := &MockAsciiColumn{name: "bar"}
+	a, b := "a", "a"
+
+	assert.Equal(s.T(), partitionOf(s.table, []ColumnBinding{{Column: barCol, Value: &a}}),
+		partitionOf(s.table, []ColumnBinding{{Column: barCol, Value: &b}}))
+	assert.Equal(s.T(), partitionOf(s.table, []ColumnBinding{{Column: barCol, Value: &a}}),
+		partitionOf(s.table, []ColumnBinding{{Column: barCol, Value: "a"}}))
+
+	b = "b"
+	assert.NotEqual(s.T(), partitionOf(s.table, []ColumnBinding{{Column: barCol, Value: &a}}),
+		partitionOf(s.table, []ColumnBinding{{Column: barCol, Value: &b}}))
+}
+
+func (s *CqlTestSuite) TestConditionalBatchBuilder() {
+	barCol := &MockAsciiColumn{name: "bar"}
+	quuxCol := &MockAsciiColumn{name: "quux"}
+	c := NewContext()
+	b := NewBatchBuilder()
+
+	var quux string
+	err := c.Upsert(s.table).SetString(barCol, "a").SetString(quuxCol, "x").
+		IfExists(ColumnBinding{Column: quuxCol, Value: &quux}).AddTo(b)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), b.statements[0].stmt, "INSERT INTO foo (bar, quux) VALUES (?,?) IF NOT EXISTS")
+
+	assert.Equal(s.T(), b.Exec(nil), ErrConditionalBatch)
+
+	_, err = b.Timestamp(1).Swap(nil)
+	assert.Equal(s.T(), err, ErrBatchTimestamp)
+
+	err = c.Upsert(s.table).SetString(barCol, "b").AddTo(b)
+	assert.NoError(s.T(), err)
+
+	_, err = NewBatchBuilder().Swap(nil)
+	assert.Equal(s.T(), err, ErrCASBindings)
+
+	b.hasTimestamp = false
+	_, err = b.Swap(nil)
+	assert.Equal(s.T(), err, ErrConditionalBatchPartitions)
+
+	err = assignBinding(b.statements[0].casBindings[0], map[string]interface{}{"quux": "y"})
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), quux, "y")
+
+	err = assignBinding(b.statements[0].casBindings[0], map[string]interface{}{"quux": 1})
+	assert.Error(s.T(), err)
+
+	type status string
+	var (
+		count    int32
+		state    status
+		optional *int64
+	)
+	err = assignBinding(ColumnBinding{Column: quuxCol, Value: &count}, map[string]interface{}{"quux": 1})
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), count, int32(1))
+
+	err = assignBinding(ColumnBinding{Column: quuxCol, Value: &state}, map[string]interface{}{"quux": "paid"})
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), state, status("paid"))
+
+	err = assignBinding(ColumnBinding{Column: quuxCol, Value: &optional}, map[string]interface{}{"quux": int64(2)})
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), *optional, int64(2))
+}
+
+func (s *CqlTestSuite) TestCounterBatchTimestamp() {
+	s.table.counter = true
+	c := NewContext()
+	b := NewBatchBuilder().Timestamp(1)
+
+	err := c.UpdateCounter(s.table).Increment(&MockCounterColumn{name: "cnt"}, 1).Having(eq(&MockAsciiColumn{name: "bar"}, "a")).AddTo(b)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), b.Exec(nil), ErrBatchTimestamp)
+
+	b.reset()
+	assert.False(s.T(), b.hasTimestamp)
+}
+
+func (s *CqlTestSuite) TestBulkWriterGrouping() {
+	barCol := &MockAsciiColumn{name: "bar"}
+	quuxCol := &MockAsciiColumn{name: "quux"}
+
+	bind := func(bar, quux string) TableBinding {
+		return TableBinding{Table: s.table, Columns: []ColumnBinding{
+			ColumnBinding{Column: barCol, Value: bar},
+			ColumnBinding{Column: quuxCol, Value: quux},
+		}}
+	}
+
+	groups, err := NewBulkWriter().group([]TableBinding{bind("a", "1"), bind("b", "2"), bind("a", "3")})
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), len(groups), 2)
+	assert.Equal(s.T(), len(groups[0].statements), 2)
+	assert.Equal(s.T(), groups[0].statements[1].placeHolders, []interface{}{"a", "3"})
+	assert.Equal(s.T(), groups[1].statements[0].stmt, "INSERT INTO foo (bar, quux) VALUES (?,?)")
+
+	unbound := TableBinding{Table: s.table, Columns: []ColumnBinding{ColumnBinding{Column: quuxCol, Value: "1"}}}
+	_, err = NewBulkWriter().group([]TableBinding{unbound})
+	assert.Equal(s.T(), err, ErrUnboundPartitionKey)
+
+	batches := NewBulkWriter().MaxStatements(1).split(groups)
+	assert.Equal(s.T(), len(batches), 3)
+	assert.Equal(s.T(), batches[0].partition, batches[1].partition)
+	assert.Equal(s.T(), len(batches[2].statements), 1)
+
+	s.table.counter = true
+	_, err = NewBulkWriter().group([]TableBinding{bind("a", "1")})
+	assert.Equal(s.T(), err, ErrBulkCounter)
+}
+
+func (s *CqlTestSuite) TestUpdateStatic() {
+	barCol := &MockAsciiColumn{name: "bar"}
+	staticCol := &MockStaticAsciiColumn{name: "location"}
+	c := NewContext()
+
+	c.UpdateStatic(s.table).SetStaticString(staticCol, "x").Having(barCol.Eq("a"))
+	stmt, placeHolders, err := BuildStatement(c)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), stmt, "UPDATE foo SET location = ? WHERE bar = ?")
+	assert.Equal(s.T(), placeHolders, []interface{}{"x", "a"})
+
+	c.UpdateStatic(s.table).SetStaticString(staticCol, "x").Having(eq(&MockInt32Column{name: "baz"}, int32(1)))
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+
+	c = NewContext()
+	c.UpdateStatic(s.table).Having(barCol.Eq("a"))
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+
+	c.UpdateStatic(s.table).SetStaticString(staticCol, "x").Having()
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+
+	c.UpdateStatic(s.table).SetStaticString(staticCol, "x").Having(gt(barCol, "a"))
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+}
+
+func (s *CqlTestSuite) TestSelectDistinctStatic() {
+	barCol := &MockAsciiColumn{name: "bar"}
+	staticCol := &MockStaticAsciiColumn{name: "location"}
+	c := NewContext()
+
+	c.SelectDistinct(barCol).Static(staticCol).From(s.table)
+	cql, err := c.RenderCQL()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), cql, "SELECT DISTINCT bar, location FROM foo")
+
+	c = NewContext()
+	c.SelectDistinct().Static(staticCol).From(s.table)
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+
+	c = NewContext()
+	c.SelectDistinct().From(s.table)
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+}
+
+func (s *CqlTestSuite) TestReadOnlyView() {
+	idCol := &MockAsciiColumn{name: "id"}
+	view := &MockView{MockTable{name: "foo_by_id"}}
+	c := NewContext()
+
+	c.Select(idCol).From(view).Where(idCol.Eq("x"))
+	cql, err := c.RenderCQL()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), cql, "SELECT id FROM foo_by_id WHERE id = ?")
+
+	c = NewContext()
+	c.Delete().From(view).Where(idCol.Eq("x"))
+	_, err = c.RenderCQL()
+	assert.Equal(s.T(), err, ErrReadOnlyView)
+
+	c = NewContext()
+	c.Store(TableBinding{Table: view, Columns: []ColumnBinding{ColumnBinding{Column: idCol, Value: "x"}}})
+	_, err = c.RenderCQL()
+	assert.Equal(s.T(), err, ErrReadOnlyView)
+}
+
 func (s *CqlTestSuite) TestDeleteRow() {
 	idCol := &MockAsciiColumn{name: "id"}
 	c := NewContext()
@@ -316,3 +647,325 @@ func (s *CqlTestSuite) TestDeleteColumn() {
 	assert.NoError(s.T(), err)
 	assert.Equal(s.T(), cql, "DELETE bar FROM foo WHERE id = ?")
 }
+
+func (s *CqlTestSuite) TestScan() {
+	idCol := &MockAsciiColumn{name: "id"}
+	barCol := &MockAsciiColumn{name: "bar"}
+	s.table.columns = []Column{idCol, barCol}
+
+	keys, err := partitionKeyColumns(s.table)
+	assert.NoError(s.T(), err)
+
+	cql := Scan(s.table).Select(barCol).renderCQL(keys)
+	assert.Equal(s.T(), cql, "SELECT bar FROM foo WHERE token(id, bar) > ? AND token(id, bar) <= ?")
+
+	scanner := Scan(s.table).Select(barCol)
+	scanner.Keyspace = "staging"
+	cql = scanner.renderCQL(keys)
+	assert.Equal(s.T(), cql, "SELECT bar FROM staging.foo WHERE token(id, bar) > ? AND token(id, bar) <= ?")
+
+	scanner.Keyspace = ""
+	scanner.StaticKeyspace = true
+	cql = scanner.renderCQL(keys)
+	assert.Equal(s.T(), cql, "SELECT bar FROM ks.foo WHERE token(id, bar) > ? AND token(id, bar) <= ?")
+}
+
+func (s *CqlTestSuite) TestTokenRanges() {
+	ranges := TokenRanges(4)
+	assert.Equal(s.T(), len(ranges), 4)
+	assert.Equal(s.T(), ranges[0].Start, int64(math.MinInt64))
+	assert.Equal(s.T(), ranges[3].End, int64(math.MaxInt64))
+
+	for i := 1; i < len(ranges); i++ {
+		assert.Equal(s.T(), ranges[i].Start, ranges[i-1].End)
+		assert.True(s.T(), ranges[i].Start < ranges[i].End)
+	}
+
+	single := TokenRanges(1)
+	assert.Equal(s.T(), single, []TokenRange{{Start: math.MinInt64, End: math.MaxInt64}})
+}
+
+func (s *CqlTestSuite) TestToken() {
+	idCol := &MockAsciiColumn{name: "id"}
+	barCol := &MockAsciiColumn{name: "bar"}
+	s.table.columns = []Column{idCol, barCol}
+	c := NewContext()
+
+	c.Select(barCol).From(s.table).Where(Token(idCol, barCol).Gt(-10), Token(idCol, barCol).Le(10))
+	cql, err := c.RenderCQL()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), cql, "SELECT bar FROM foo WHERE token(id, bar) > ? AND token(id, bar) <= ?")
+
+	c.Select(barCol).From(s.table).Where(Token(barCol, idCol).Ge(0))
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+
+	c.Select(barCol).From(s.table).Where(Token(idCol).Lt(0))
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+
+	c.Delete().From(s.table).Where(Token(idCol, barCol).Gt(0))
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+
+	c.Upsert(s.table).SetString(barCol, "x").Where(Token(idCol, barCol).Gt(0))
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+}
+
+func (s *CqlTestSuite) TestTuple() {
+	idCol := &MockAsciiColumn{name: "id"}
+	quuxCol := &MockInt32Column{name: "quux"}
+	bazCol := &MockInt32Column{name: "baz"}
+	s.table.columns = []Column{idCol, quuxCol, bazCol}
+	c := NewContext()
+
+	c.Select(idCol).From(s.table).Where(idCol.Eq("x"), Tuple(quuxCol, bazCol).Gt(int32(1), int32(2)))
+	stmt, placeHolders, err := BuildStatement(c)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), stmt, "SELECT id FROM foo WHERE id = ? AND (quux, baz) > (?,?)")
+	assert.Equal(s.T(), placeHolders, []interface{}{"x", int32(1), int32(2)})
+
+	c.Select(idCol).From(s.table).Where(idCol.Eq("x"), Tuple(quuxCol, bazCol).In([]interface{}{1, 2}, []interface{}{3, 4}))
+	stmt, placeHolders, err = BuildStatement(c)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), stmt, "SELECT id FROM foo WHERE id = ? AND (quux, baz) IN ((?,?),(?,?))")
+	assert.Equal(s.T(), placeHolders, []interface{}{"x", 1, 2, 3, 4})
+
+	c.Select(idCol).From(s.table).Where(idCol.Eq("x"), Tuple(quuxCol, bazCol).Le(1))
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+
+	c.Select(idCol).From(s.table).Where(idCol.Eq("x"), Tuple(quuxCol).Gt(1))
+	_, err = c.RenderCQL()
+	assert.NoError(s.T(), err)
+
+	c.Select(idCol).From(s.table).Where(idCol.Eq("x"), Tuple(bazCol, quuxCol).Gt(1, 2))
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+
+	c.Select(idCol).From(s.table).Where(idCol.Eq("x"), Tuple(bazCol).Gt(1))
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+}
+
+func (s *CqlTestSuite) TestAllowFiltering() {
+	idCol := &MockAsciiColumn{name: "id"}
+	barCol := &MockAsciiColumn{name: "bar"}
+	quuxCol := &MockInt32Column{name: "quux"}
+	c := NewContext()
+
+	c.Select(barCol).From(s.table).Where(idCol.Eq("x")).AllowFiltering(FilterCondition{gt(quuxCol, 10)}).Limit(5)
+	cql, err := c.RenderCQL()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), cql, "SELECT bar FROM foo WHERE id = ? AND quux > ? LIMIT 5 ALLOW FILTERING")
+}
+
+func (s *CqlTestSuite) TestDisposeResetsReadOptions() {
+	idCol := &MockAsciiColumn{name: "id"}
+	barCol := &MockAsciiColumn{name: "bar"}
+	quuxCol := &MockInt32Column{name: "quux"}
+	c := NewContext()
+
+	c.SelectJson(barCol).From(s.table).Where(idCol.Eq("x")).AllowFiltering(FilterCondition{gt(quuxCol, 10)}).Limit(5)
+	_, err := c.RenderCQL()
+	assert.NoError(s.T(), err)
+
+	c.Dispose()
+	c.Select(barCol).From(s.table).Where(idCol.Eq("x"))
+	cql, err := c.RenderCQL()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), cql, "SELECT bar FROM foo WHERE id = ?")
+}
+
+func (s *CqlTestSuite) TestGroupBy() {
+	idCol := &MockAsciiColumn{name: "id"}
+	quuxCol := &MockInt32Column{name: "quux"}
+	barCol := &MockAsciiColumn{name: "bar"}
+	s.table.columns = []Column{idCol, quuxCol}
+	c := NewContext()
+
+	c.Select(idCol, quuxCol).From(s.table).Where(idCol.Eq("x")).GroupBy(idCol, quuxCol)
+	cql, err := c.RenderCQL()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), cql, "SELECT id, quux FROM foo WHERE id = ? GROUP BY id, quux")
+
+	c = NewContext()
+	c.Select(idCol).From(s.table).GroupBy(quuxCol)
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+
+	c = NewContext()
+	c.Select(idCol).From(s.table).GroupBy(idCol, barCol)
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+}
+
+func (s *CqlTestSuite) TestPerPartitionLimit() {
+	idCol := &MockAsciiColumn{name: "id"}
+	quuxCol := &MockInt32Column{name: "quux"}
+	c := NewContext()
+
+	c.Select(idCol, quuxCol).From(s.table).PerPartitionLimit(3).OrderBy(quuxCol.Desc()).Limit(10)
+	cql, err := c.RenderCQL()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), cql, "SELECT id, quux FROM foo ORDER BY quux DESC PER PARTITION LIMIT 3 LIMIT 10")
+}
+
+func (s *CqlTestSuite) TestSelectJson() {
+	idCol := &MockAsciiColumn{name: "id"}
+	barCol := &MockAsciiColumn{name: "bar"}
+	c := NewContext()
+
+	c.SelectJson(barCol).From(s.table).Where(idCol.Eq("x"))
+	cql, err := c.RenderCQL()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), cql, "SELECT JSON bar FROM foo WHERE id = ?")
+}
+
+func (s *CqlTestSuite) TestInsertJson() {
+	s.table.columns = []Column{&MockAsciiColumn{name: "id"}, &MockAsciiColumn{name: "bar"}}
+	c := NewContext()
+
+	c.InsertJson(s.table, []byte(`{"id": "x", "BAR": "y"}`))
+	stmt, placeHolders, err := BuildStatement(c)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), stmt, "INSERT INTO foo JSON ?")
+	assert.Equal(s.T(), placeHolders, []interface{}{`{"id": "x", "BAR": "y"}`})
+
+	c.InsertJson(s.table, []byte(`{"id": "x"}`)).DefaultUnset()
+	cql, err := c.RenderCQL()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), cql, "INSERT INTO foo JSON ? DEFAULT UNSET")
+
+	c.Dispose()
+	c.InsertJson(s.table, []byte(`{"id": "x"}`)).DefaultNull()
+	cql, err = c.RenderCQL()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), cql, "INSERT INTO foo JSON ? DEFAULT NULL")
+
+	c.InsertJson(s.table, []byte(`{"id": "x", "quux": 1}`))
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+
+	c.InsertJson(s.table, []byte(`{"\"BAR\"": "y"}`))
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+
+	c.InsertJson(s.table, []byte(`[1, 2]`))
+	_, err = c.RenderCQL()
+	assert.Error(s.T(), err)
+}
+
+func (s *CqlTestSuite) TestSelectors() {
+	idCol := &MockAsciiColumn{name: "id"}
+	quuxCol := &MockInt32Column{name: "quux"}
+	c := NewContext()
+
+	c.Select(AliasString(idCol, "key"), CastAsInt64(quuxCol).As("Total"), ToJson(quuxCol), BlobAsInt64(&MockBytesColumn{name: "raw"}).As("raw_int")).
+		From(s.table)
+	cql, err := c.RenderCQL()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), cql, `SELECT id AS key, CAST(quux AS bigint) AS "Total", toJson(quux), blobAsBigint(raw) AS raw_int FROM foo`)
+
+	var total int64
+	c.Bind(CastAsInt64(quuxCol).As("Total").To(&total))
+	_, ok := c.ResultBindings["Total"]
+	assert.True(s.T(), ok)
+}
+
+func (s *CqlTestSuite) TestTimeUUIDBounds() {
+	idCol := &MockAsciiColumn{name: "id"}
+	whenCol := &MockInt32Column{name: "when"}
+	from := time.Unix(1000, 0)
+	to := time.Unix(2000, 0)
+	c := NewContext()
+
+	c.Select(idCol).From(s.table).Where(idCol.Eq("x"), mockCondition(whenCol, MaxTimeUUID(from), GtPredicate))
+	stmt, placeHolders, err := BuildStatement(c)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), stmt, "SELECT id FROM foo WHERE id = ? AND when > maxTimeuuid(?)")
+	assert.Equal(s.T(), placeHolders, []interface{}{"x", from})
+
+	between := []interface{}{MinTimeUUID(from), MaxTimeUUID(to)}
+	c.Select(idCol).From(s.table).Where(idCol.Eq("x"), mockCondition(whenCol, between, BetweenPredicate))
+	stmt, placeHolders, err = BuildStatement(c)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), stmt, "SELECT id FROM foo WHERE id = ? AND when >= minTimeuuid(?) AND when <= maxTimeuuid(?)")
+	assert.Equal(s.T(), placeHolders, []interface{}{"x", from, to})
+}
+
+func (s *CqlTestSuite) TestServerFunctions() {
+	idCol := &MockAsciiColumn{name: "id"}
+	whenCol := &MockTimeUUIDColumn{name: "when"}
+	c := NewContext()
+
+	c.Upsert(s.table).SetString(idCol, "x").SetTimeUUIDFunction(whenCol, Now())
+	stmt, placeHolders, err := BuildStatement(c)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), stmt, "INSERT INTO foo (id, when) VALUES (?,now())")
+	assert.Equal(s.T(), placeHolders, []interface{}{"x"})
+
+	c.Upsert(s.table).SetTimeUUIDFunction(whenCol, Now()).Where(idCol.Eq("x"))
+	stmt, placeHolders, err = BuildStatement(c)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), stmt, "UPDATE foo SET when = now() WHERE id = ?")
+	assert.Equal(s.T(), placeHolders, []interface{}{"x"})
+}
+
+func (s *CqlTestSuite) TestValidateSchema() {
+
+	id := &MockAsciiColumn{name: "id"}
+	reading := &MockInt32Column{name: "reading"}
+	location := &MockStaticAsciiColumn{name: "location"}
+
+	table := &MockTable{name: "readings", keyspace: "ks", columns: []Column{id, reading, location}}
+
+	native := func(name string, kind gocql.ColumnKind, t gocql.Type) *gocql.ColumnMetadata {
+		return &gocql.ColumnMetadata{Name: name, Kind: kind, Type: gocql.NewNativeType(3, t, "")}
+	}
+
+	live := &gocql.TableMetadata{
+		Name: "readings",
+		Columns: map[string]*gocql.ColumnMetadata{
+			"id":       native("id", gocql.PARTITION_KEY, gocql.TypeAscii),
+			"reading":  native("reading", gocql.CLUSTERING_KEY, gocql.TypeInt),
+			"location": native("location", gocql.STATIC, gocql.TypeVarchar),
+		},
+	}
+	live.PartitionKey = []*gocql.ColumnMetadata{live.Columns["id"]}
+	live.ClusteringColumns = []*gocql.ColumnMetadata{live.Columns["reading"]}
+
+	md := &gocql.KeyspaceMetadata{Name: "ks", Tables: map[string]*gocql.TableMetadata{"readings": live}}
+
+	assert.Empty(s.T(), validateTable(table, md))
+
+	// The reading is now a bigint regular column and the partition key has a new column
+	live.Columns["reading"] = native("reading", gocql.REGULAR, gocql.TypeBigInt)
+	live.Columns["sensor"] = native("sensor", gocql.PARTITION_KEY, gocql.TypeAscii)
+	live.PartitionKey = []*gocql.ColumnMetadata{live.Columns["id"], live.Columns["sensor"]}
+	live.ClusteringColumns = nil
+	delete(live.Columns, "location")
+
+	problems := make([]string, 0)
+	for _, mismatch := range validateTable(table, md) {
+		problems = append(problems, mismatch.Error())
+	}
+
+	assert.Equal(s.T(), problems, []string{
+		"Table ks.readings: column reading has type bigint, but was generated as int",
+		"Table ks.readings: column location does not exist",
+		"Table ks.readings: column sensor was not generated",
+		"Table ks.readings: has the partition key (id, sensor), but was generated with (id)",
+		"Table ks.readings: has the clustering columns (), but was generated with (reading)",
+	})
+
+	delete(md.Tables, "readings")
+	assert.Equal(s.T(), validateTable(table, md)[0].Error(), "Table ks.readings: does not exist")
+
+	// Mismatches name the keyspace that was validated rather than the one the table was generated against
+	staging := &gocql.KeyspaceMetadata{Name: "staging", Tables: map[string]*gocql.TableMetadata{}}
+	assert.Equal(s.T(), validateTable(table, staging)[0].Error(), "Table staging.readings: does not exist")
+
+	assert.Equal(s.T(), TypeName(gocql.NewNativeType(3, gocql.TypeVarchar, "")), "text")
+}
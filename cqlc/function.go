@@ -0,0 +1,147 @@
+package cqlc
+
+import (
+	"fmt"
+	"time"
+)
+
+// FunctionValue is a value that is rendered as a CQL function call in place of a bare placeholder.
+type FunctionValue interface {
+	// Expression returns the CQL function call, which may itself contain placeholders.
+	Expression() string
+	// Arguments returns the values that are bound to the placeholders in the expression.
+	Arguments() []interface{}
+}
+
+// TimeUUIDFunction is a CQL function that yields a timeuuid when it is evaluated by the server.
+type TimeUUIDFunction struct {
+	call string
+}
+
+func (f TimeUUIDFunction) Expression() string {
+	return f.call
+}
+
+func (f TimeUUIDFunction) Arguments() []interface{} {
+	return nil
+}
+
+// UUIDFunction is a CQL function that yields a uuid when it is evaluated by the server.
+type UUIDFunction struct {
+	call string
+}
+
+func (f UUIDFunction) Expression() string {
+	return f.call
+}
+
+func (f UUIDFunction) Arguments() []interface{} {
+	return nil
+}
+
+// TimestampFunction is a CQL function that yields a timestamp when it is evaluated by the server.
+type TimestampFunction struct {
+	call string
+}
+
+func (f TimestampFunction) Expression() string {
+	return f.call
+}
+
+func (f TimestampFunction) Arguments() []interface{} {
+	return nil
+}
+
+// Now generates a timeuuid for the current time on the coordinator using the CQL now function.
+func Now() TimeUUIDFunction {
+	return TimeUUIDFunction{call: "now()"}
+}
+
+// NewUUID generates a random uuid on the coordinator using the CQL uuid function.
+func NewUUID() UUIDFunction {
+	return UUIDFunction{call: "uuid()"}
+}
+
+// CurrentTimestamp yields the current time of the coordinator using the CQL currentTimestamp function.
+func CurrentTimestamp() TimestampFunction {
+	return TimestampFunction{call: "currentTimestamp()"}
+}
+
+func (c *Context) SetTimeUUIDFunction(col TimeUUIDColumn, value TimeUUIDFunction) SetValueStep {
+	set(c, col, value)
+	return c
+}
+
+func (c *Context) SetUUIDFunction(col UUIDColumn, value UUIDFunction) SetValueStep {
+	set(c, col, value)
+	return c
+}
+
+func (c *Context) SetTimestampFunction(col TimestampColumn, value TimestampFunction) SetValueStep {
+	set(c, col, value)
+	return c
+}
+
+type timeUUIDBound struct {
+	function string
+	t        time.Time
+}
+
+func (b *timeUUIDBound) Expression() string {
+	return fmt.Sprintf("%s(?)", b.function)
+}
+
+func (b *timeUUIDBound) Arguments() []interface{} {
+	return []interface{}{b.t}
+}
+
+// MinTimeUUID represents the smallest timeuuid that can be generated for the given time,
+// using the CQL minTimeuuid function.
+func MinTimeUUID(t time.Time) FunctionValue {
+	return &timeUUIDBound{function: "minTimeuuid", t: t}
+}
+
+// MaxTimeUUID represents the largest timeuuid that can be generated for the given time,
+// using the CQL maxTimeuuid function.
+func MaxTimeUUID(t time.Time) FunctionValue {
+	return &timeUUIDBound{function: "maxTimeuuid", t: t}
+}
+
+// valueExpression returns the CQL that a value is rendered as in a statement.
+func valueExpression(v interface{}) string {
+	if fn, ok := v.(FunctionValue); ok {
+		return fn.Expression()
+	}
+	return "?"
+}
+
+// bindingArguments returns the placeholder values of a binding,
+// which are none for values that are computed entirely by the server.
+func bindingArguments(b ColumnBinding) []interface{} {
+	if fn, ok := b.Value.(FunctionValue); ok {
+		return fn.Arguments()
+	}
+	return []interface{}{b.Value}
+}
+
+// functionArguments returns the placeholder values of a condition that involves
+// function values, or false if the condition is bound to plain values.
+func functionArguments(cond Condition) ([]interface{}, bool) {
+	if cond.Predicate == BetweenPredicate {
+		args := make([]interface{}, 0)
+		for _, bound := range cond.Binding.Value.([]interface{}) {
+			if fn, ok := bound.(FunctionValue); ok {
+				args = append(args, fn.Arguments()...)
+			} else {
+				args = append(args, bound)
+			}
+		}
+		return args, true
+	}
+
+	if fn, ok := cond.Binding.Value.(FunctionValue); ok {
+		return fn.Arguments(), true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,119 @@
+package cqlc
+
+import (
+	"encoding/json"
+	"github.com/gocql/gocql"
+	"strings"
+)
+
+type JsonDefaultType int
+
+const (
+	NoJsonDefault JsonDefaultType = iota
+	JsonDefaultNull
+	JsonDefaultUnset
+)
+
+// jsonResultColumn is the name of the column that SELECT JSON returns each row in.
+const jsonResultColumn = "[json]"
+
+type InsertJsonStep interface {
+	Executable
+	// DefaultNull causes columns that are omitted from the document to be set to null.
+	DefaultNull() Executable
+	// DefaultUnset causes columns that are omitted from the document to be left untouched.
+	DefaultUnset() Executable
+}
+
+// jsonDocument is the pseudo column that an INSERT JSON document is bound to.
+type jsonDocument struct{}
+
+func (j *jsonDocument) ColumnName() string {
+	return "JSON"
+}
+
+// InsertJson builds an INSERT JSON statement for the supplied document.
+// Every key in the document needs to be a column of the target table, otherwise
+// the statement is rejected before it is sent to the server.
+func (c *Context) InsertJson(t Upsertable, doc []byte) InsertJsonStep {
+	c.Table = t
+	c.Operation = JsonInsertOperation
+	c.Bindings = []ColumnBinding{ColumnBinding{Column: &jsonDocument{}, Value: string(doc)}}
+	return c
+}
+
+func (c *Context) DefaultNull() Executable {
+	c.JsonDefault = JsonDefaultNull
+	return c
+}
+
+func (c *Context) DefaultUnset() Executable {
+	c.JsonDefault = JsonDefaultUnset
+	return c
+}
+
+// BindJson reads every row of a SELECT JSON query as a raw JSON document.
+func BindJson(iter *gocql.Iter) ([]json.RawMessage, error) {
+	array := make([]json.RawMessage, 0)
+	err := MapJson(iter, func(doc json.RawMessage) (bool, error) {
+		array = append(array, doc)
+		return true, nil
+	})
+	return array, err
+}
+
+// MapJson invokes the callback with the raw JSON document of each row returned by a SELECT JSON query.
+func MapJson(iter *gocql.Iter, callback func(doc json.RawMessage) (bool, error)) error {
+	columns := iter.Columns()
+	if len(columns) != 1 || columns[0].Name != jsonResultColumn {
+		return bindingErrorf("Query did not return JSON rows")
+	}
+
+	var doc string
+
+	for iter.Scan(&doc) {
+		readNext, err := callback(json.RawMessage(doc))
+		if err != nil {
+			return err
+		}
+		if !readNext {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// validateJsonDocument verifies that the document of an INSERT JSON statement
+// is a JSON object that only refers to columns of the target table.
+func validateJsonDocument(c *Context) error {
+	if len(c.Bindings) != 1 {
+		return bindingErrorf("INSERT JSON requires a single document")
+	}
+
+	doc, _ := c.Bindings[0].Value.(string)
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(doc), &fields); err != nil {
+		return bindingErrorf("Invalid JSON document: %s", err)
+	}
+
+	columns := make(map[string]bool)
+	for _, col := range c.Table.ColumnDefinitions() {
+		columns[col.ColumnName()] = true
+	}
+
+	for key, _ := range fields {
+		// Unquoted keys are case insensitive, quoted keys need to match the column name exactly
+		name := strings.ToLower(key)
+		if len(key) > 1 && strings.HasPrefix(key, `"`) && strings.HasSuffix(key, `"`) {
+			name = key[1 : len(key)-1]
+		}
+
+		if !columns[name] {
+			return bindingErrorf("Unknown column %s in JSON document for table %s", key, c.Table.TableName())
+		}
+	}
+
+	return nil
+}
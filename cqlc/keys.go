@@ -0,0 +1,68 @@
+package cqlc
+
+import (
+	"errors"
+)
+
+var (
+	ErrNoPartitionKey = errors.New("Table has no partition key columns")
+)
+
+// PartitionedTable is implemented by tables that know the declared order of their
+// partition key columns, which is the order in which the token() function hashes them.
+type PartitionedTable interface {
+	Table
+	PartitionKeyColumns() []PartitionedColumn
+}
+
+// ClusteredTable is implemented by tables that know the declared order of their clustering columns.
+type ClusteredTable interface {
+	Table
+	ClusteringColumns() []ClusteredColumn
+}
+
+// partitionKeyColumns returns the partition key columns of a table in declaration order.
+// Tables that do not implement PartitionedTable fall back to the column definitions
+// that are marked as PartitionedColumn.
+func partitionKeyColumns(t Table) ([]Column, error) {
+	cols := make([]Column, 0)
+
+	if pt, ok := t.(PartitionedTable); ok {
+		for _, col := range pt.PartitionKeyColumns() {
+			cols = append(cols, col.PartitionBy())
+		}
+	} else {
+		for _, col := range t.ColumnDefinitions() {
+			if pc, ok := col.(PartitionedColumn); ok {
+				cols = append(cols, pc.PartitionBy())
+			}
+		}
+	}
+
+	if len(cols) == 0 {
+		return nil, ErrNoPartitionKey
+	}
+
+	return cols, nil
+}
+
+// clusteringColumnNames returns the names of the clustering columns of a table in declaration order.
+// Tables that do not implement ClusteredTable fall back to the column definitions
+// that are marked as ClusteredColumn.
+func clusteringColumnNames(t Table) []string {
+	names := make([]string, 0)
+
+	if ct, ok := t.(ClusteredTable); ok {
+		for _, col := range ct.ClusteringColumns() {
+			names = append(names, col.ClusterWith())
+		}
+	} else {
+		for _, col := range t.ColumnDefinitions() {
+			if cc, ok := col.(ClusteredColumn); ok {
+				names = append(names, cc.ClusterWith())
+			}
+		}
+	}
+
+	return names
+}
@@ -17,10 +17,14 @@ var predicateTypes = map[PredicateType]string{
 
 func renderSelect(ctx *Context, buf *bytes.Buffer) {
 
+	fmt.Fprint(buf, "SELECT ")
+
+	if ctx.ReadOptions.Json {
+		fmt.Fprint(buf, "JSON ")
+	}
+
 	if ctx.ReadOptions.Distinct {
-		fmt.Fprint(buf, "SELECT DISTINCT ")
-	} else {
-		fmt.Fprint(buf, "SELECT ")
+		fmt.Fprint(buf, "DISTINCT ")
 	}
 
 	var colClause string
@@ -33,19 +37,17 @@ func renderSelect(ctx *Context, buf *bytes.Buffer) {
 
 	fmt.Fprint(buf, colClause)
 
-	if ctx.Keyspace == "" && !ctx.StaticKeyspace {
-		fmt.Fprintf(buf, " FROM %s", ctx.Table.TableName())
-	} else if ctx.StaticKeyspace {
-		fmt.Fprintf(buf, " FROM %s.%s", ctx.Table.Keyspace(), ctx.Table.TableName())
-	} else {
-		fmt.Fprintf(buf, " FROM %s.%s", ctx.Keyspace, ctx.Table.TableName())
-	}
+	fmt.Fprintf(buf, " FROM %s", tableName(ctx))
 
 	if ctx.hasConditions() {
 		fmt.Fprint(buf, " ")
 		renderWhereClause(ctx, buf)
 	}
 
+	if len(ctx.ReadOptions.Grouping) > 0 {
+		fmt.Fprintf(buf, " GROUP BY %s", strings.Join(ctx.ReadOptions.Grouping, ", "))
+	}
+
 	if len(ctx.ReadOptions.Ordering) > 0 {
 
 		orderByFragments := make([]string, len(ctx.ReadOptions.Ordering))
@@ -62,28 +64,34 @@ func renderSelect(ctx *Context, buf *bytes.Buffer) {
 		fmt.Fprintf(buf, " ORDER BY %s", orderBy)
 	}
 
+	if ctx.ReadOptions.PerPartitionLimit > 0 {
+		fmt.Fprintf(buf, " PER PARTITION LIMIT %d", ctx.ReadOptions.PerPartitionLimit)
+	}
+
 	if ctx.ReadOptions.Limit > 0 {
 		fmt.Fprintf(buf, " LIMIT %d", ctx.ReadOptions.Limit)
 	}
+
+	if ctx.ReadOptions.AllowFiltering {
+		fmt.Fprint(buf, " ALLOW FILTERING")
+	}
 }
 
 func columnClause(cols []Column) string {
 	colFragments := make([]string, len(cols))
 	for i, col := range cols {
-		colFragments[i] = col.ColumnName()
+		if sel, ok := col.(Selector); ok {
+			colFragments[i] = sel.Expression()
+		} else {
+			colFragments[i] = col.ColumnName()
+		}
 	}
 	return strings.Join(colFragments, ", ")
 }
 
 func renderInsert(ctx *Context, buf *bytes.Buffer) {
 
-	if ctx.Keyspace == "" && !ctx.StaticKeyspace {
-		fmt.Fprintf(buf, "INSERT INTO %s (", ctx.Table.TableName())
-	} else if ctx.StaticKeyspace {
-		fmt.Fprintf(buf, "INSERT INTO %s.%s (", ctx.Table.Keyspace(), ctx.Table.TableName())
-	} else {
-		fmt.Fprintf(buf, "INSERT INTO %s.%s (", ctx.Keyspace, ctx.Table.TableName())
-	}
+	fmt.Fprintf(buf, "INSERT INTO %s (", tableName(ctx))
 
 	colFragments := make([]string, len(ctx.Bindings))
 	for i, binding := range ctx.Bindings {
@@ -96,8 +104,8 @@ func renderInsert(ctx *Context, buf *bytes.Buffer) {
 	fmt.Fprint(buf, ") VALUES (")
 
 	placeHolderFragments := make([]string, len(ctx.Bindings))
-	for i, _ := range ctx.Bindings {
-		placeHolderFragments[i] = "?"
+	for i, binding := range ctx.Bindings {
+		placeHolderFragments[i] = valueExpression(binding.Value)
 	}
 
 	placeHolderClause := strings.Join(placeHolderFragments, ",")
@@ -106,22 +114,31 @@ func renderInsert(ctx *Context, buf *bytes.Buffer) {
 
 }
 
-func renderUpdate(ctx *Context, buf *bytes.Buffer, counterTable bool) {
+func renderInsertJson(ctx *Context, buf *bytes.Buffer) {
+	fmt.Fprintf(buf, "INSERT INTO %s JSON ?", tableName(ctx))
 
-	if ctx.Keyspace == "" && !ctx.StaticKeyspace {
-		fmt.Fprintf(buf, "UPDATE %s SET ", ctx.Table.TableName())
-	} else if ctx.StaticKeyspace {
-		fmt.Fprintf(buf, "UPDATE %s.%s SET ", ctx.Table.Keyspace(), ctx.Table.TableName())
-	} else {
-		fmt.Fprintf(buf, "UPDATE %s.%s SET ", ctx.Keyspace, ctx.Table.TableName())
+	switch ctx.JsonDefault {
+	case JsonDefaultNull:
+		fmt.Fprint(buf, " DEFAULT NULL")
+	case JsonDefaultUnset:
+		fmt.Fprint(buf, " DEFAULT UNSET")
 	}
+}
+
+func renderUpdate(ctx *Context, buf *bytes.Buffer, counterTable bool) {
+
+	fmt.Fprintf(buf, "UPDATE %s SET ", tableName(ctx))
 
 	setFragments := make([]string, len(ctx.Bindings))
 	for i, binding := range ctx.Bindings {
 		col := binding.Column.ColumnName()
 
 		if counterTable {
-			setFragments[i] = fmt.Sprintf("%s = %s + ?", col, col)
+			if binding.CollectionOperationType == Subtract {
+				setFragments[i] = fmt.Sprintf("%s = %s - ?", col, col)
+			} else {
+				setFragments[i] = fmt.Sprintf("%s = %s + ?", col, col)
+			}
 		} else {
 
 			switch binding.CollectionType {
@@ -135,7 +152,7 @@ func renderUpdate(ctx *Context, buf *bytes.Buffer, counterTable bool) {
 					setFragments[i] = fmt.Sprintf("%s = %s - ?", col, col)
 				}
 			default:
-				setFragments[i] = fmt.Sprintf("%s = ?", col)
+				setFragments[i] = fmt.Sprintf("%s = %s", col, valueExpression(binding.Value))
 			}
 		}
 	}
@@ -161,13 +178,7 @@ func renderDelete(ctx *Context, buf *bytes.Buffer) {
 		fmt.Fprint(buf, " ")
 	}
 
-	if ctx.Keyspace == "" && !ctx.StaticKeyspace {
-		fmt.Fprintf(buf, "FROM %s ", ctx.Table.TableName())
-	} else if ctx.StaticKeyspace {
-		fmt.Fprintf(buf, "FROM %s.%s ", ctx.Table.Keyspace(), ctx.Table.TableName())
-	} else {
-		fmt.Fprintf(buf, "FROM %s.%s ", ctx.Keyspace, ctx.Table.TableName())
-	}
+	fmt.Fprintf(buf, "FROM %s ", tableName(ctx))
 
 	renderWhereClause(ctx, buf)
 }
@@ -177,6 +188,12 @@ func renderWhereClause(ctx *Context, buf *bytes.Buffer) {
 
 	whereFragments := make([]string, len(ctx.Conditions))
 	for i, condition := range ctx.Conditions {
+
+		if tuple, ok := condition.Binding.Column.(*TupleColumn); ok {
+			whereFragments[i] = renderTupleCondition(tuple, condition)
+			continue
+		}
+
 		col := condition.Binding.Column.ColumnName()
 
 		pred := condition.Predicate
@@ -191,11 +208,28 @@ func renderWhereClause(ctx *Context, buf *bytes.Buffer) {
 			valueString := strings.Join(placeHolders, ",")
 			whereFragments[i] = fmt.Sprintf("%s IN (%s)", col, valueString)
 
+		} else if pred == BetweenPredicate {
+
+			bounds := condition.Binding.Value.([]interface{})
+			whereFragments[i] = fmt.Sprintf("%s >= %s AND %s <= %s",
+				col, valueExpression(bounds[0]), col, valueExpression(bounds[1]))
+
 		} else {
-			whereFragments[i] = fmt.Sprintf("%s %s ?", col, predicateTypes[pred])
+			whereFragments[i] = fmt.Sprintf("%s %s %s", col, predicateTypes[pred], valueExpression(condition.Binding.Value))
 		}
 	}
 
 	whereClause := strings.Join(whereFragments, " AND ")
 	fmt.Fprint(buf, whereClause)
 }
+
+// tableName returns the name of the target table, qualified by a keyspace if the context requires it.
+func tableName(ctx *Context) string {
+	if ctx.Keyspace == "" && !ctx.StaticKeyspace {
+		return ctx.Table.TableName()
+	} else if ctx.StaticKeyspace {
+		return fmt.Sprintf("%s.%s", ctx.Table.Keyspace(), ctx.Table.TableName())
+	} else {
+		return fmt.Sprintf("%s.%s", ctx.Keyspace, ctx.Table.TableName())
+	}
+}
@@ -0,0 +1,170 @@
+package cqlc
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gocql/gocql"
+	"math"
+	"sync"
+)
+
+const (
+	defaultScanSplits      = 16
+	defaultScanParallelism = 4
+)
+
+// TokenRange is a half open range of the Murmur3 token ring, i.e. (Start, End].
+type TokenRange struct {
+	Start int64
+	End   int64
+}
+
+// Scanner reads an entire table by splitting the token ring into ranges
+// and querying each of them concurrently.
+type Scanner struct {
+	table       Table
+	columns     []Column
+	splits      int
+	parallelism int
+	pageSize    int
+	// Debug flag will cause all CQL statements to get logged
+	Debug bool
+	// Setting Keyspace to a non-zero value will cause CQL statements to be qualified by this keyspace.
+	Keyspace string
+	// Setting StaticKeyspace to true will cause the generated CQL to be qualified by the keyspace the code was generated against.
+	StaticKeyspace bool
+}
+
+// Scan creates a full table scan that splits the token ring of the table's
+// partition key into a number of ranges that are read in parallel.
+func Scan(t Table) *Scanner {
+	return &Scanner{
+		table:       t,
+		splits:      defaultScanSplits,
+		parallelism: defaultScanParallelism,
+	}
+}
+
+// Select restricts the columns that are read by the scan.
+// If no columns are specified, all columns of the table are read.
+func (s *Scanner) Select(cols ...Column) *Scanner {
+	s.columns = cols
+	return s
+}
+
+// Splits sets the number of token ranges the ring is divided into.
+func (s *Scanner) Splits(n int) *Scanner {
+	s.splits = n
+	return s
+}
+
+// Parallelism sets the maximum number of token ranges that are queried at the same time.
+func (s *Scanner) Parallelism(n int) *Scanner {
+	s.parallelism = n
+	return s
+}
+
+// PageSize sets the page size of each of the token range queries.
+func (s *Scanner) PageSize(n int) *Scanner {
+	s.pageSize = n
+	return s
+}
+
+// Each queries every token range and hands the resulting iterator to the callback,
+// which will typically map the rows using the generated Map<Table> function.
+// The callback is invoked concurrently for up to Parallelism token ranges, so it
+// needs to synchronize access to any state that it shares.
+// Each waits for all ranges to complete and returns the first error encountered.
+func (s *Scanner) Each(session *gocql.Session, callback func(r TokenRange, iter *gocql.Iter) error) error {
+
+	keys, err := partitionKeyColumns(s.table)
+	if err != nil {
+		return err
+	}
+
+	stmt := s.renderCQL(keys)
+
+	parallelism := s.parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	ranges := TokenRanges(s.splits)
+	sem := make(chan struct{}, parallelism)
+	errs := make(chan error, len(ranges))
+
+	var wg sync.WaitGroup
+
+	for _, r := range ranges {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(r TokenRange) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if s.Debug {
+				debugStmt(stmt, []interface{}{r.Start, r.End})
+			}
+
+			q := session.Query(stmt, r.Start, r.End)
+			if s.pageSize > 0 {
+				q.PageSize(s.pageSize)
+			}
+
+			iter := q.Iter()
+			cbErr := callback(r, iter)
+
+			if err := iter.Close(); err != nil {
+				errs <- err
+			} else if cbErr != nil {
+				errs <- cbErr
+			}
+		}(r)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	return <-errs
+}
+
+func (s *Scanner) renderCQL(keys []Column) string {
+	var buf bytes.Buffer
+
+	cols := s.columns
+	if len(cols) == 0 {
+		cols = s.table.ColumnDefinitions()
+	}
+
+	token := tokenClause(keys)
+
+	fmt.Fprintf(&buf, "SELECT %s FROM %s WHERE %s > ? AND %s <= ?",
+		columnClause(cols), tableName(&Context{Table: s.table, Keyspace: s.Keyspace, StaticKeyspace: s.StaticKeyspace}), token, token)
+
+	return buf.String()
+}
+
+// TokenRanges divides the Murmur3 token ring into n contiguous ranges of
+// roughly equal size that together cover the entire ring.
+// The minimum token is excluded, since Murmur3 never assigns it to a partition.
+func TokenRanges(n int) []TokenRange {
+	if n < 1 {
+		n = 1
+	}
+
+	width := uint64(math.MaxUint64) / uint64(n)
+	ranges := make([]TokenRange, n)
+
+	start := int64(math.MinInt64)
+	for i := 0; i < n; i++ {
+		end := int64(math.MaxInt64)
+		if i < n-1 {
+			end = int64(uint64(start) + width)
+		}
+		ranges[i] = TokenRange{Start: start, End: end}
+		start = end
+	}
+
+	return ranges
+}
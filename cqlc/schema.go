@@ -0,0 +1,202 @@
+package cqlc
+
+import (
+	"fmt"
+	"github.com/gocql/gocql"
+	"sort"
+	"strings"
+)
+
+// TypedColumn is implemented by generated columns, which know the CQL type they were generated from.
+type TypedColumn interface {
+	Column
+	CQLType() string
+}
+
+// SchemaMismatch describes a difference between a table definition and the schema of the cluster.
+type SchemaMismatch struct {
+	Keyspace string
+	Table    string
+	// Column is empty if the mismatch concerns the table as a whole
+	Column  string
+	Problem string
+}
+
+func (m *SchemaMismatch) Error() string {
+	if m.Column != "" {
+		return fmt.Sprintf("Table %s.%s: column %s %s", m.Keyspace, m.Table, m.Column, m.Problem)
+	}
+	return fmt.Sprintf("Table %s.%s: %s", m.Keyspace, m.Table, m.Problem)
+}
+
+// SchemaMismatches is returned when one or more table definitions do not match the schema of the cluster.
+type SchemaMismatches []*SchemaMismatch
+
+func (e SchemaMismatches) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ValidateSchema compares table definitions with the schema of the cluster, so that an application
+// can refuse to start if its generated code is out of date, rather than failing on the first query.
+// Missing tables and columns, columns that were not generated, type mismatches and changes to the
+// primary key are returned as SchemaMismatches.
+// Each table is looked up in the keyspace it was generated against, use ValidateKeyspaceSchema
+// if the application sets Context.Keyspace to run against another keyspace.
+// Materialized views are validated in the same way as tables, as gocql reports them alongside the tables.
+func ValidateSchema(s *gocql.Session, tables ...Table) error {
+	return ValidateKeyspaceSchema(s, "", tables...)
+}
+
+// ValidateKeyspaceSchema compares table definitions with the schema of a keyspace in the same way as ValidateSchema.
+// An empty keyspace looks up each table in the keyspace it was generated against.
+func ValidateKeyspaceSchema(s *gocql.Session, keyspace string, tables ...Table) error {
+	keyspaces := make(map[string]*gocql.KeyspaceMetadata)
+
+	var mismatches SchemaMismatches
+
+	for _, t := range tables {
+		name := keyspace
+		if name == "" {
+			name = t.Keyspace()
+		}
+
+		md, ok := keyspaces[name]
+		if !ok {
+			var err error
+			if md, err = s.KeyspaceMetadata(name); err != nil {
+				return err
+			}
+			keyspaces[name] = md
+		}
+
+		mismatches = append(mismatches, validateTable(t, md)...)
+	}
+
+	if len(mismatches) > 0 {
+		return mismatches
+	}
+
+	return nil
+}
+
+func validateTable(t Table, md *gocql.KeyspaceMetadata) []*SchemaMismatch {
+	mismatches := make([]*SchemaMismatch, 0)
+	mismatch := func(column, problem string, args ...interface{}) {
+		mismatches = append(mismatches, &SchemaMismatch{
+			Keyspace: md.Name,
+			Table:    t.TableName(),
+			Column:   column,
+			Problem:  fmt.Sprintf(problem, args...),
+		})
+	}
+
+	table, ok := md.Tables[t.TableName()]
+	if !ok {
+		mismatch("", "does not exist")
+		return mismatches
+	}
+
+	generated := make(map[string]bool)
+	for _, col := range t.ColumnDefinitions() {
+		name := col.ColumnName()
+		generated[name] = true
+
+		live, ok := table.Columns[name]
+		if !ok {
+			mismatch(name, "does not exist")
+			continue
+		}
+
+		if tc, ok := col.(TypedColumn); ok && tc.CQLType() != TypeName(live.Type) {
+			mismatch(name, "has type %s, but was generated as %s", TypeName(live.Type), tc.CQLType())
+		}
+
+		_, static := col.(StaticColumn)
+		if isStatic := live.Kind == gocql.STATIC; isStatic != static {
+			if isStatic {
+				mismatch(name, "is static, but was generated as a regular column")
+			} else {
+				mismatch(name, "is not static, but was generated as a static column")
+			}
+		}
+	}
+
+	extra := make([]string, 0)
+	for name := range table.Columns {
+		if !generated[name] {
+			extra = append(extra, name)
+		}
+	}
+	sort.Strings(extra)
+	for _, name := range extra {
+		mismatch(name, "was not generated")
+	}
+
+	partitionKey := make([]string, 0)
+	if cols, err := partitionKeyColumns(t); err == nil {
+		for _, col := range cols {
+			partitionKey = append(partitionKey, col.ColumnName())
+		}
+	}
+	if live := columnNames(table.PartitionKey); live != strings.Join(partitionKey, ", ") {
+		mismatch("", "has the partition key (%s), but was generated with (%s)", live, strings.Join(partitionKey, ", "))
+	}
+
+	clustering := strings.Join(clusteringColumnNames(t), ", ")
+	if live := columnNames(table.ClusteringColumns); live != clustering {
+		mismatch("", "has the clustering columns (%s), but was generated with (%s)", live, clustering)
+	}
+
+	return mismatches
+}
+
+func columnNames(cols []*gocql.ColumnMetadata) string {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = col.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+var typeNames = map[gocql.Type]string{
+	gocql.TypeAscii:     "ascii",
+	gocql.TypeVarchar:   "text",
+	gocql.TypeInt:       "int",
+	gocql.TypeBigInt:    "bigint",
+	gocql.TypeFloat:     "float",
+	gocql.TypeDouble:    "double",
+	gocql.TypeTimestamp: "timestamp",
+	gocql.TypeTimeUUID:  "timeuuid",
+	gocql.TypeUUID:      "uuid",
+	gocql.TypeBoolean:   "boolean",
+	gocql.TypeCounter:   "counter",
+	gocql.TypeBlob:      "blob",
+	gocql.TypeDecimal:   "decimal",
+	gocql.TypeVarint:    "varint",
+}
+
+// TypeName renders a type the way it is written in CQL, without any whitespace.
+// Varchar columns are reported as text, which is an alias of varchar.
+func TypeName(t gocql.TypeInfo) string {
+	switch t.Type() {
+	case gocql.TypeList, gocql.TypeSet, gocql.TypeMap:
+		// TODO should probably not swallow this
+		ct, _ := t.(gocql.CollectionType)
+		if t.Type() == gocql.TypeMap {
+			return fmt.Sprintf("map<%s,%s>", TypeName(ct.Key), TypeName(ct.Elem))
+		}
+		outer := "list"
+		if t.Type() == gocql.TypeSet {
+			outer = "set"
+		}
+		return fmt.Sprintf("%s<%s>", outer, TypeName(ct.Elem))
+	}
+	if name, ok := typeNames[t.Type()]; ok {
+		return name
+	}
+	return strings.ToLower(strings.Replace(fmt.Sprint(t), " ", "", -1))
+}
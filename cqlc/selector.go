@@ -0,0 +1,57 @@
+package cqlc
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var unquotedIdentifier = regexp.MustCompile("^[a-z][a-z0-9_]*$")
+
+// Selector is implemented by expressions in a select clause that are more than a bare column,
+// such as CQL function calls and aliased columns.
+// Selectors should be aliased in order to bind their result, since the server
+// may not echo the expression back verbatim as the name of the result column.
+type Selector interface {
+	Column
+	// Expression returns the CQL fragment that is rendered into the select clause.
+	Expression() string
+}
+
+// ToTimestamp converts a timeuuid column to a timestamp using the CQL toTimestamp function.
+func ToTimestamp(col TimeUUIDColumn) *TimestampSelector {
+	return &TimestampSelector{expression: fmt.Sprintf("toTimestamp(%s)", col.ColumnName())}
+}
+
+// DateOf extracts the timestamp of a timeuuid column using the CQL dateOf function.
+func DateOf(col TimeUUIDColumn) *TimestampSelector {
+	return &TimestampSelector{expression: fmt.Sprintf("dateOf(%s)", col.ColumnName())}
+}
+
+// ToJson renders the value of a column as a JSON encoded string using the CQL toJson function.
+func ToJson(col Column) *StringSelector {
+	return &StringSelector{expression: fmt.Sprintf("toJson(%s)", col.ColumnName())}
+}
+
+func selectorName(expression, alias string) string {
+	if alias == "" {
+		return expression
+	}
+	return alias
+}
+
+func selectorExpression(expression, alias string) string {
+	if alias == "" {
+		return expression
+	}
+	return fmt.Sprintf("%s AS %s", expression, quoteIdentifier(alias))
+}
+
+// quoteIdentifier quotes identifiers that would otherwise be case folded or rejected by the server,
+// so that the name of the result column matches the alias exactly.
+func quoteIdentifier(name string) string {
+	if unquotedIdentifier.MatchString(name) {
+		return name
+	}
+	return fmt.Sprintf(`"%s"`, strings.Replace(name, `"`, `""`, -1))
+}
@@ -0,0 +1,82 @@
+package cqlc
+
+import (
+	"fmt"
+)
+
+// TokenColumn represents the CQL token() function applied to the partition key of a table.
+// It can be used to restrict a query to a range of the token ring.
+type TokenColumn struct {
+	cols []PartitionedColumn
+}
+
+// Token applies the token() function to the given partition key columns.
+// The columns need to be the complete partition key of the table being queried,
+// in the order in which they were declared.
+func Token(cols ...PartitionedColumn) *TokenColumn {
+	return &TokenColumn{cols: cols}
+}
+
+func (t *TokenColumn) ColumnName() string {
+	return tokenClause(t.columns())
+}
+
+func (t *TokenColumn) Gt(value int64) Condition {
+	return t.condition(value, GtPredicate)
+}
+
+func (t *TokenColumn) Ge(value int64) Condition {
+	return t.condition(value, GePredicate)
+}
+
+func (t *TokenColumn) Lt(value int64) Condition {
+	return t.condition(value, LtPredicate)
+}
+
+func (t *TokenColumn) Le(value int64) Condition {
+	return t.condition(value, LePredicate)
+}
+
+func (t *TokenColumn) condition(value int64, pred PredicateType) Condition {
+	binding := ColumnBinding{Column: t, Value: value}
+	return Condition{Binding: binding, Predicate: pred}
+}
+
+func (t *TokenColumn) columns() []Column {
+	cols := make([]Column, len(t.cols))
+	for i, col := range t.cols {
+		cols[i] = col.PartitionBy()
+	}
+	return cols
+}
+
+// validateTokenConditions verifies that any token() restriction in the WHERE clause
+// covers the partition key of the target table in the correct order.
+// Cassandra only accepts token() restrictions in SELECT statements.
+func validateTokenConditions(c *Context) error {
+	for _, cond := range c.Conditions {
+		token, ok := cond.Binding.Column.(*TokenColumn)
+		if !ok {
+			continue
+		}
+
+		if c.Operation != ReadOperation {
+			return fmt.Errorf("Invalid token restriction %s, token() can only restrict SELECT statements", token.ColumnName())
+		}
+
+		keys, err := partitionKeyColumns(c.Table)
+		if err != nil {
+			return err
+		}
+
+		if token.ColumnName() != tokenClause(keys) {
+			return fmt.Errorf("Invalid token restriction %s, table %s is partitioned by %s",
+				token.ColumnName(), c.Table.TableName(), tokenClause(keys))
+		}
+	}
+	return nil
+}
+
+func tokenClause(cols []Column) string {
+	return fmt.Sprintf("token(%s)", columnClause(cols))
+}
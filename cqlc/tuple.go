@@ -0,0 +1,131 @@
+package cqlc
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// TupleColumn groups a number of clustering columns into a multi-column relation,
+// such as (a, b) > (?,?) or (a, b) IN ((?,?),(?,?)).
+// This allows seek based paging across composite clustering keys.
+type TupleColumn struct {
+	cols []ClusteredColumn
+}
+
+// Tuple combines the given clustering columns into a multi-column relation.
+// The columns need to be a prefix of the clustering key, in the order in which they were declared.
+func Tuple(cols ...ClusteredColumn) *TupleColumn {
+	return &TupleColumn{cols: cols}
+}
+
+func (t *TupleColumn) ColumnName() string {
+	names := make([]string, len(t.cols))
+	for i, col := range t.cols {
+		names[i] = col.ClusterWith()
+	}
+	return fmt.Sprintf("(%s)", strings.Join(names, ", "))
+}
+
+func (t *TupleColumn) Eq(values ...interface{}) Condition {
+	return t.condition(values, EqPredicate)
+}
+
+func (t *TupleColumn) Gt(values ...interface{}) Condition {
+	return t.condition(values, GtPredicate)
+}
+
+func (t *TupleColumn) Ge(values ...interface{}) Condition {
+	return t.condition(values, GePredicate)
+}
+
+func (t *TupleColumn) Lt(values ...interface{}) Condition {
+	return t.condition(values, LtPredicate)
+}
+
+func (t *TupleColumn) Le(values ...interface{}) Condition {
+	return t.condition(values, LePredicate)
+}
+
+// In restricts the tuple to one of the supplied value lists, each of which
+// needs to contain a value for every column in the tuple.
+func (t *TupleColumn) In(values ...[]interface{}) Condition {
+	return t.condition(values, InPredicate)
+}
+
+func (t *TupleColumn) condition(values interface{}, pred PredicateType) Condition {
+	binding := ColumnBinding{Column: t, Value: values}
+	return Condition{Binding: binding, Predicate: pred}
+}
+
+// validateTupleConditions verifies that the columns of each multi-column relation are a prefix
+// of the clustering key in declared order, and that exactly one value is supplied per column.
+func validateTupleConditions(c *Context) error {
+	for _, cond := range c.Conditions {
+		tuple, ok := cond.Binding.Column.(*TupleColumn)
+		if !ok {
+			continue
+		}
+
+		clustering := clusteringColumnNames(c.Table)
+		if len(tuple.cols) > len(clustering) {
+			return bindingErrorf("Tuple %s has more columns than the clustering key (%s)",
+				tuple.ColumnName(), strings.Join(clustering, ", "))
+		}
+		for i, col := range tuple.cols {
+			if col.ClusterWith() != clustering[i] {
+				return bindingErrorf("Tuple %s is not a prefix of the clustering key (%s)",
+					tuple.ColumnName(), strings.Join(clustering, ", "))
+			}
+		}
+
+		var rows [][]interface{}
+		if cond.Predicate == InPredicate {
+			rows = cond.Binding.Value.([][]interface{})
+		} else {
+			rows = [][]interface{}{cond.Binding.Value.([]interface{})}
+		}
+
+		for _, row := range rows {
+			if len(row) != len(tuple.cols) {
+				return bindingErrorf("Tuple %s requires %d values, but %d were supplied",
+					tuple.ColumnName(), len(tuple.cols), len(row))
+			}
+		}
+	}
+	return nil
+}
+
+// renderTupleCondition renders a multi-column relation with a placeholder for each value.
+func renderTupleCondition(tuple *TupleColumn, cond Condition) string {
+	row := make([]string, len(tuple.cols))
+	for i := range row {
+		row[i] = "?"
+	}
+	placeHolders := fmt.Sprintf("(%s)", strings.Join(row, ","))
+
+	if cond.Predicate == InPredicate {
+		rows := reflect.ValueOf(cond.Binding.Value).Len()
+		tuples := make([]string, rows)
+		for i := range tuples {
+			tuples[i] = placeHolders
+		}
+		return fmt.Sprintf("%s IN (%s)", tuple.ColumnName(), strings.Join(tuples, ","))
+	}
+
+	return fmt.Sprintf("%s %s %s", tuple.ColumnName(), predicateTypes[cond.Predicate], placeHolders)
+}
+
+// tupleValues flattens the values of a multi-column relation into the
+// order of the placeholders rendered by renderTupleCondition.
+func tupleValues(cond Condition) []interface{} {
+	if cond.Predicate != InPredicate {
+		return cond.Binding.Value.([]interface{})
+	}
+
+	values := make([]interface{}, 0)
+	for _, row := range cond.Binding.Value.([][]interface{}) {
+		values = append(values, row...)
+	}
+	return values
+}
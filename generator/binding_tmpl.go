@@ -27,149 +27,237 @@ func bindata_read(data []byte, name string) ([]byte, error) {
 
 func generator_tmpl_binding_tmpl() ([]byte, error) {
 	return bindata_read([]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x00, 0xff, 0xec, 0x5a,
-		0xdf, 0x6f, 0xda, 0xba, 0x17, 0x7f, 0xe7, 0xaf, 0xf0, 0xaa, 0xaa, 0x0a,
-		0x1d, 0x5f, 0xb6, 0x67, 0xbe, 0xb7, 0x0f, 0x8c, 0x66, 0x6d, 0x34, 0x2e,
-		0xb0, 0x26, 0x5d, 0x35, 0x4d, 0xd3, 0x64, 0x82, 0x69, 0xa3, 0x86, 0x84,
-		0x25, 0xa6, 0x1b, 0x8a, 0xf8, 0xdf, 0xef, 0xb1, 0x63, 0x13, 0xc7, 0x71,
-		0x28, 0x14, 0x2a, 0xdd, 0x5d, 0xe1, 0x97, 0x86, 0xe4, 0xf8, 0xfc, 0xf8,
-		0x9c, 0x8f, 0x7f, 0x1d, 0xf7, 0xdd, 0x3b, 0xe4, 0x5d, 0x3b, 0x2e, 0xfa,
-		0xe8, 0xf4, 0x6d, 0x74, 0xd7, 0x75, 0x51, 0xf7, 0xd6, 0x1b, 0x5e, 0xd9,
-		0x03, 0xfb, 0xa6, 0xeb, 0xd9, 0x97, 0xe8, 0x7f, 0xa8, 0x3b, 0xf8, 0x8a,
-		0xec, 0x4b, 0xc7, 0x73, 0x91, 0x37, 0xcc, 0x45, 0xef, 0x9c, 0x7e, 0x1f,
-		0x7d, 0xb0, 0x51, 0x7f, 0xe8, 0x7a, 0xe8, 0xee, 0xda, 0x1e, 0x20, 0xc7,
-		0x43, 0xf0, 0xfe, 0xc6, 0x5e, 0xf7, 0x6b, 0x80, 0xda, 0x42, 0xc9, 0xad,
-		0xeb, 0x0c, 0xae, 0xd0, 0x27, 0xfb, 0xab, 0x3b, 0xea, 0xf6, 0x6c, 0x94,
-		0x65, 0xa8, 0x3d, 0x4a, 0xe2, 0x27, 0x12, 0xe1, 0xc8, 0x27, 0xed, 0x4f,
-		0x64, 0x99, 0xce, 0xb1, 0x4f, 0xd0, 0x6a, 0xc5, 0xba, 0x75, 0x3d, 0x5d,
-		0xc0, 0x0b, 0x66, 0x24, 0xa5, 0x78, 0x36, 0x07, 0x09, 0xa1, 0xcb, 0xff,
-		0x19, 0xfa, 0xe8, 0x8b, 0x7d, 0xe3, 0x3a, 0xc3, 0x81, 0x2e, 0xfe, 0x85,
-		0x24, 0x69, 0x10, 0x47, 0x52, 0xdd, 0x55, 0xd7, 0x19, 0x80, 0x9f, 0xd7,
-		0xcc, 0x59, 0xe7, 0x52, 0x17, 0xbe, 0x8e, 0x53, 0xea, 0x4c, 0x98, 0x62,
-		0xcb, 0xb5, 0x6f, 0x40, 0x63, 0x9d, 0x56, 0x97, 0x24, 0x4f, 0x24, 0xb9,
-		0x21, 0x21, 0xc1, 0x29, 0x73, 0xb5, 0xc9, 0x94, 0xf7, 0xfa, 0x8e, 0x3d,
-		0xf0, 0xd0, 0xc0, 0xbe, 0x1a, 0x7a, 0x0e, 0x0f, 0xb5, 0xf7, 0xb9, 0x5f,
-		0xa7, 0x61, 0x40, 0xee, 0x63, 0x1a, 0x60, 0x4a, 0x26, 0x4c, 0x48, 0xb1,
-		0xe8, 0xde, 0x8e, 0x46, 0xc3, 0x1b, 0x00, 0xf8, 0x76, 0xc4, 0x30, 0x36,
-		0x1a, 0xce, 0xbb, 0x34, 0x1b, 0x0d, 0xf8, 0x7a, 0x9a, 0x2e, 0x67, 0xe3,
-		0x38, 0x4c, 0x51, 0xe7, 0x02, 0xb5, 0x87, 0x73, 0x0a, 0xc1, 0xa6, 0x6d,
-		0x57, 0xbc, 0x83, 0xa8, 0x99, 0xc8, 0xa3, 0x04, 0x95, 0xc9, 0xd4, 0x80,
-		0xdd, 0x80, 0x87, 0x47, 0x7c, 0x4f, 0xb8, 0x45, 0xa9, 0x67, 0x24, 0xde,
-		0xb1, 0xef, 0xc1, 0x6c, 0x1e, 0x27, 0x14, 0x59, 0x0d, 0x04, 0x2d, 0xcb,
-		0x12, 0x1c, 0xc1, 0x87, 0xd3, 0x1f, 0x2d, 0x74, 0x3a, 0xc7, 0xf4, 0x81,
-		0xab, 0x76, 0xb8, 0x48, 0x0a, 0xd2, 0x48, 0xb4, 0x93, 0x2c, 0xe3, 0x9f,
-		0x57, 0xab, 0x13, 0xd1, 0x8f, 0x44, 0x13, 0xf8, 0x0e, 0xbe, 0xfb, 0x60,
-		0x40, 0xaa, 0x83, 0x80, 0x7a, 0x3f, 0x24, 0x52, 0x17, 0xac, 0x57, 0x4d,
-		0x12, 0x4f, 0x1a, 0x3c, 0x6a, 0xc5, 0xb8, 0x3f, 0xe5, 0xa6, 0x3d, 0x3c,
-		0x0e, 0x09, 0xb3, 0x2c, 0xcc, 0xa0, 0x53, 0x97, 0x26, 0x0b, 0x9f, 0x7a,
-		0xcb, 0x39, 0x0f, 0x3b, 0x8d, 0xf0, 0x23, 0xf1, 0xe2, 0x1e, 0x9e, 0x91,
-		0x90, 0x75, 0x6a, 0x0f, 0xe0, 0x09, 0x15, 0xf2, 0xaa, 0xc6, 0x38, 0x64,
-		0x3d, 0x98, 0x50, 0x2f, 0x0e, 0x17, 0xb3, 0x48, 0x0d, 0x88, 0x69, 0x86,
-		0xb7, 0x1b, 0x95, 0xc7, 0xe1, 0x5a, 0xbb, 0xda, 0xed, 0xf3, 0x02, 0x87,
-		0xc1, 0x34, 0x80, 0x8c, 0x57, 0xfa, 0xcf, 0x93, 0x20, 0xa2, 0x25, 0x97,
-		0x35, 0x23, 0x8a, 0x2a, 0xca, 0x7e, 0x03, 0xac, 0x66, 0x75, 0xab, 0x55,
-		0xee, 0x33, 0x4a, 0xf9, 0xab, 0x6c, 0xdd, 0x4d, 0x78, 0x11, 0x4c, 0x51,
-		0xba, 0x98, 0xf3, 0x34, 0xf5, 0xc2, 0x45, 0x4a, 0x09, 0x58, 0xbe, 0xcf,
-		0x63, 0x56, 0x6c, 0xb0, 0x36, 0x21, 0xa9, 0x8f, 0xc6, 0x71, 0x1c, 0xea,
-		0x2a, 0x20, 0x83, 0xaa, 0xac, 0x80, 0x90, 0xb5, 0xe9, 0x22, 0xf2, 0x91,
-		0x35, 0x46, 0xe7, 0x5b, 0xf8, 0xd7, 0x44, 0xf9, 0x03, 0x43, 0xca, 0x6a,
-		0x32, 0x77, 0x99, 0x23, 0x65, 0x7f, 0x13, 0x42, 0x17, 0x49, 0xc4, 0x49,
-		0x24, 0x41, 0x95, 0x44, 0xd2, 0x2c, 0xe7, 0x91, 0x05, 0x69, 0x3f, 0x48,
-		0x05, 0x7e, 0x22, 0xa2, 0x92, 0xbe, 0xdd, 0xfc, 0x93, 0xba, 0xc0, 0x3b,
-		0x36, 0xc3, 0x08, 0x32, 0x68, 0x2e, 0x2a, 0x6e, 0x9e, 0x6d, 0x48, 0xb2,
-		0x54, 0x9b, 0x95, 0x31, 0x2e, 0x47, 0x20, 0x80, 0x7d, 0x21, 0x9c, 0x5e,
-		0x6c, 0x3d, 0xe1, 0x70, 0x41, 0xd0, 0x79, 0x96, 0xf1, 0x87, 0x35, 0x0e,
-		0x30, 0x55, 0xa8, 0x11, 0x7c, 0x08, 0xa2, 0x49, 0x2d, 0xd6, 0x55, 0xb9,
-		0x2c, 0xff, 0xd5, 0x41, 0xe3, 0x16, 0xfa, 0xc2, 0xf4, 0x76, 0x10, 0x57,
-		0x6f, 0x64, 0x40, 0x9e, 0x87, 0x07, 0x9c, 0xba, 0x04, 0xc6, 0xf7, 0x04,
-		0x27, 0x4b, 0x27, 0x9a, 0x90, 0xdf, 0x46, 0x82, 0xed, 0x16, 0x9e, 0xfd,
-		0x53, 0x84, 0xb7, 0x21, 0x3a, 0xf0, 0x97, 0xcd, 0x5b, 0x86, 0x14, 0xf9,
-		0xb9, 0x1a, 0x18, 0x6b, 0x67, 0xcf, 0x1a, 0xd3, 0x72, 0xc4, 0xda, 0x58,
-		0x40, 0x06, 0xfd, 0x37, 0x00, 0x94, 0x1b, 0xa9, 0x43, 0xc9, 0x0c, 0xb4,
-		0x70, 0x39, 0x13, 0xba, 0x3a, 0xd2, 0x54, 0x0b, 0x8d, 0x12, 0x32, 0x09,
-		0x7c, 0x58, 0x21, 0x3a, 0xb9, 0xac, 0xfd, 0x73, 0xfd, 0x46, 0x27, 0xd1,
-		0x06, 0x0e, 0xc9, 0x81, 0xd1, 0x8b, 0x17, 0x11, 0x0c, 0x76, 0x01, 0xe7,
-		0xfe, 0xe9, 0xe8, 0xe1, 0xc8, 0x89, 0xfc, 0x84, 0xcc, 0x48, 0x44, 0x61,
-		0x80, 0xb0, 0x79, 0xa2, 0x7e, 0x64, 0x80, 0x02, 0xb2, 0xc1, 0xe5, 0x30,
-		0x25, 0xba, 0x33, 0xe5, 0x99, 0x6a, 0x84, 0x13, 0xca, 0x61, 0xaa, 0x9b,
-		0xab, 0x8a, 0x2e, 0x51, 0x0c, 0x6b, 0x8a, 0x99, 0x7f, 0x4d, 0x53, 0xaf,
-		0xdd, 0x23, 0xdf, 0x8f, 0x88, 0xb2, 0xed, 0x49, 0x48, 0xd9, 0x0e, 0x49,
-		0x4c, 0x2d, 0x69, 0x87, 0x23, 0xa8, 0x6c, 0xc6, 0x9c, 0x69, 0xab, 0xc9,
-		0xcb, 0x72, 0xb2, 0x26, 0xc8, 0x87, 0xe5, 0xb3, 0xb3, 0xb5, 0x12, 0xe2,
-		0xb8, 0xf2, 0xb1, 0x8e, 0x56, 0xb0, 0xb2, 0xe0, 0x94, 0xf6, 0x62, 0xd8,
-		0xe0, 0x44, 0x84, 0x2d, 0xd5, 0x8c, 0x84, 0x6c, 0xef, 0x71, 0x10, 0x4a,
-		0x39, 0x91, 0xa0, 0x54, 0xbb, 0xdd, 0x3e, 0xb2, 0xaa, 0xcc, 0x2a, 0x27,
-		0x3a, 0x10, 0xab, 0x6a, 0xdf, 0x6e, 0xde, 0x12, 0xed, 0xc9, 0x4c, 0xa1,
-		0xf1, 0x2e, 0xa0, 0x0f, 0x75, 0xbb, 0x1c, 0x0d, 0xa1, 0x71, 0x5b, 0xdd,
-		0x18, 0x19, 0x18, 0xba, 0xa7, 0x47, 0x97, 0xb0, 0xaf, 0x5b, 0x0f, 0x92,
-		0xdc, 0x3b, 0x2e, 0xfc, 0xec, 0x68, 0xd9, 0x82, 0x52, 0x6c, 0xcf, 0xd8,
-		0xe1, 0xd3, 0x7d, 0x35, 0x2b, 0x7b, 0x3b, 0xee, 0xa4, 0xcc, 0x75, 0xc2,
-		0x79, 0x52, 0xbf, 0xe4, 0x94, 0x90, 0x64, 0xfe, 0x6c, 0xe3, 0xc8, 0x71,
-		0xed, 0xf8, 0x93, 0xd7, 0x8e, 0xe3, 0x9c, 0x8d, 0xfe, 0x5d, 0xd9, 0x3c,
-		0xd8, 0x9c, 0xbd, 0x7b, 0x5a, 0xae, 0xe8, 0xbe, 0x23, 0xec, 0x00, 0xf9,
-		0x38, 0x74, 0x2e, 0x5e, 0x9a, 0x87, 0x2b, 0xba, 0x21, 0x0f, 0x7b, 0x23,
-		0x4d, 0x8e, 0x48, 0x17, 0x48, 0x93, 0x57, 0x44, 0xba, 0x7f, 0xe4, 0x74,
-		0x81, 0x74, 0xff, 0x35, 0x39, 0xdd, 0x3f, 0x72, 0x5a, 0x41, 0x7a, 0x7b,
-		0x4e, 0x57, 0x67, 0x6f, 0x6d, 0x75, 0x96, 0x75, 0x5f, 0xfe, 0x43, 0x56,
-		0x30, 0x55, 0x44, 0x44, 0xbd, 0x52, 0x41, 0x75, 0xfb, 0x9a, 0x6c, 0x2e,
-		0x6d, 0x2e, 0xc2, 0x82, 0xe6, 0x6a, 0x2e, 0x15, 0x1b, 0xb9, 0x5b, 0x79,
-		0x44, 0x3b, 0x96, 0x82, 0x73, 0x6a, 0xa5, 0x39, 0xb5, 0xd4, 0x58, 0x9a,
-		0x1b, 0xbc, 0xe1, 0xe9, 0xb2, 0x9a, 0x06, 0xa7, 0xcc, 0xf5, 0xb8, 0xb4,
-		0x5d, 0xaf, 0xab, 0x51, 0x4e, 0xc6, 0xb3, 0x18, 0x5f, 0x92, 0xe9, 0xfe,
-		0x30, 0xd3, 0xf8, 0x76, 0x3e, 0x27, 0x89, 0x86, 0x70, 0xce, 0xc6, 0xa2,
-		0xf2, 0xaa, 0x6d, 0xb3, 0x0c, 0x38, 0x73, 0xf4, 0x18, 0x09, 0x35, 0x27,
-		0xad, 0x00, 0x0e, 0x24, 0xe8, 0xfc, 0x3e, 0x06, 0x0e, 0xb6, 0x1d, 0x78,
-		0x6e, 0x22, 0xeb, 0xdb, 0x77, 0x4d, 0xa8, 0x85, 0x48, 0x92, 0xc4, 0xf0,
-		0xa9, 0x88, 0x03, 0x27, 0x09, 0x5e, 0x32, 0xe7, 0x67, 0x00, 0x96, 0xa9,
-		0xc7, 0xfb, 0xe2, 0x2c, 0x05, 0x9d, 0x99, 0xe4, 0xdf, 0x78, 0x6e, 0x32,
-		0xde, 0xe2, 0xbe, 0x59, 0xb4, 0x9a, 0x56, 0x8b, 0x9d, 0x39, 0x0c, 0xb6,
-		0x0b, 0xfb, 0x17, 0x08, 0x03, 0x3a, 0xd1, 0xc4, 0xe2, 0x3f, 0x5b, 0x88,
-		0x36, 0x4d, 0x49, 0x65, 0x07, 0xa4, 0x16, 0x8a, 0x82, 0xa2, 0xb4, 0xbe,
-		0x2a, 0xe4, 0x84, 0x8c, 0x50, 0x00, 0xb6, 0x2a, 0xa8, 0xd5, 0xf8, 0xad,
-		0x82, 0xd6, 0x42, 0x3e, 0x0e, 0xc3, 0x31, 0xf6, 0x1f, 0xb7, 0x0b, 0xa6,
-		0x99, 0xff, 0x55, 0x62, 0xca, 0x33, 0xca, 0xaf, 0x95, 0x98, 0x72, 0xc9,
-		0x07, 0xe5, 0x44, 0x9a, 0xc4, 0xbf, 0x14, 0xbc, 0x03, 0x56, 0x5e, 0x9c,
-		0x62, 0x9f, 0x64, 0x80, 0x75, 0x48, 0x22, 0x4b, 0x28, 0x68, 0x36, 0x95,
-		0x82, 0x76, 0xc9, 0x02, 0xa7, 0x29, 0xd3, 0xa0, 0xb9, 0x96, 0xe9, 0x55,
-		0x7b, 0xe8, 0x15, 0x30, 0xb9, 0xf7, 0xff, 0x87, 0xbf, 0x7f, 0x95, 0x94,
-		0xc3, 0x9b, 0xb7, 0x6f, 0x0d, 0x53, 0x71, 0xfa, 0x2b, 0xa0, 0xfe, 0x83,
-		0x0c, 0xe2, 0x5b, 0xf0, 0x3d, 0xbf, 0x91, 0xa9, 0x0a, 0xee, 0x46, 0xff,
-		0x35, 0x38, 0xec, 0xda, 0x4f, 0xbb, 0x96, 0xe8, 0x30, 0x40, 0xc0, 0x12,
-		0x70, 0xe0, 0x8c, 0x6e, 0x33, 0x6a, 0x0b, 0x17, 0x8a, 0xc1, 0xa1, 0xb6,
-		0x09, 0x99, 0xe2, 0x45, 0x48, 0x3b, 0x46, 0x0f, 0xc2, 0xf8, 0xbe, 0xfd,
-		0x11, 0x53, 0x1c, 0x5a, 0x27, 0x8b, 0xe8, 0x01, 0x47, 0x93, 0x90, 0x4c,
-		0x44, 0xb8, 0x1d, 0x74, 0xd2, 0xd2, 0x23, 0x37, 0xd5, 0x11, 0xea, 0x7f,
-		0xc1, 0xe1, 0xe9, 0x0d, 0x4f, 0xba, 0xeb, 0xe3, 0xc8, 0x82, 0xb0, 0xe0,
-		0x8c, 0xa3, 0xf3, 0x9d, 0xb5, 0x71, 0x42, 0xf0, 0x63, 0xdd, 0x85, 0x06,
-		0x6b, 0xf0, 0x7d, 0x32, 0x20, 0xbf, 0x69, 0x4b, 0x0e, 0x39, 0xc9, 0x4a,
-		0x4b, 0x1b, 0x18, 0x60, 0x91, 0x49, 0xbc, 0xb9, 0x60, 0xa3, 0xa2, 0xbe,
-		0x90, 0x2c, 0x47, 0x43, 0xbd, 0xdb, 0xd2, 0x62, 0xbd, 0x0e, 0x75, 0xd8,
-		0x95, 0x75, 0x28, 0xce, 0x6b, 0xb2, 0xc5, 0xb5, 0x62, 0xb5, 0x98, 0xfe,
-		0x11, 0xcf, 0x82, 0x70, 0xa9, 0xcf, 0x78, 0xb5, 0x8b, 0x03, 0x9b, 0x84,
-		0x59, 0x2d, 0x43, 0xe8, 0xe0, 0xf7, 0x96, 0xe6, 0x6a, 0x86, 0xa9, 0x78,
-		0x2e, 0x67, 0x7a, 0x5e, 0x34, 0xdf, 0xde, 0x9c, 0x2b, 0xea, 0x5b, 0xb7,
-		0xf3, 0x94, 0x24, 0x35, 0xf5, 0xfa, 0xcd, 0xe6, 0x94, 0xb5, 0xfc, 0x19,
-		0x5b, 0x3c, 0xa2, 0xba, 0x5b, 0x3d, 0xf5, 0x46, 0x6f, 0x5a, 0xba, 0xd0,
-		0xdb, 0x4e, 0xb9, 0xbc, 0xb1, 0x7e, 0x46, 0xb7, 0xbc, 0xf0, 0xde, 0x4d,
-		0x39, 0x5b, 0x8d, 0xac, 0xa7, 0xea, 0x4c, 0xc9, 0x77, 0x43, 0x3c, 0xac,
-		0xea, 0xd5, 0x99, 0x2f, 0xae, 0xde, 0xbf, 0x7d, 0x37, 0xec, 0xd5, 0x5e,
-		0xbc, 0xc8, 0xbe, 0xe8, 0x8e, 0x59, 0x76, 0x3d, 0xd0, 0x3d, 0x33, 0x8f,
-		0xae, 0x7e, 0xff, 0xb9, 0xdd, 0x6d, 0x67, 0xb1, 0x3f, 0xdd, 0x30, 0x23,
-		0xae, 0x5a, 0xc6, 0x8d, 0x42, 0x41, 0x40, 0x25, 0xbb, 0x95, 0x5c, 0x64,
-		0xfc, 0x07, 0xf7, 0x47, 0x4f, 0x29, 0x33, 0x2f, 0x10, 0xe6, 0xfb, 0xe5,
-		0xb4, 0xba, 0x01, 0xd9, 0x40, 0xe3, 0x18, 0xa8, 0x70, 0x7e, 0xe4, 0xc2,
-		0x2b, 0x70, 0xe1, 0xec, 0x8f, 0x23, 0x43, 0xde, 0x11, 0x9e, 0x83, 0x88,
-		0x9f, 0x9e, 0x60, 0x1b, 0x54, 0xce, 0x72, 0x75, 0x1a, 0x2a, 0x7d, 0xd6,
-		0xff, 0x0d, 0x63, 0xd7, 0x3d, 0xc7, 0x1e, 0x2c, 0x78, 0x05, 0x26, 0xb0,
-		0xb6, 0x65, 0xca, 0xb5, 0xb8, 0x4d, 0xf9, 0x54, 0x33, 0xa0, 0x81, 0xcf,
-		0xb3, 0x09, 0xa8, 0x03, 0xda, 0xfa, 0x48, 0x64, 0x79, 0xa9, 0x60, 0x6e,
-		0x4a, 0xfb, 0x7f, 0x0e, 0x79, 0xf3, 0x11, 0x6c, 0xeb, 0x41, 0xb8, 0x7d,
-		0x46, 0xf2, 0xdd, 0xce, 0xfa, 0xff, 0xcb, 0x14, 0xb1, 0x27, 0x9c, 0x94,
-		0xdc, 0x90, 0x2b, 0x39, 0xaa, 0xec, 0xe5, 0x8b, 0x0c, 0x9a, 0x76, 0x12,
-		0x7f, 0xd2, 0xbf, 0x5d, 0xad, 0x67, 0x88, 0x2a, 0xc7, 0x9a, 0x06, 0xf7,
-		0x24, 0xe2, 0xfc, 0xa8, 0x6f, 0x3e, 0x1d, 0x9b, 0xf7, 0x5f, 0x3b, 0xfe,
-		0x17, 0x91, 0x76, 0xf4, 0x17, 0x0f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-		0x96, 0x1d, 0x87, 0x6d, 0xc8, 0x29, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xed, 0x5c,
+		0x5d, 0x73, 0xda, 0x38, 0x17, 0xbe, 0xe7, 0x57, 0x68, 0x33, 0x9d, 0x0e,
+		0xce, 0xb2, 0x4e, 0xaf, 0xd9, 0x37, 0x17, 0x34, 0x21, 0xa9, 0x67, 0x29,
+		0x49, 0x03, 0x69, 0x67, 0xa7, 0xd3, 0xd9, 0x11, 0x46, 0x04, 0x4f, 0x8c,
+		0xed, 0x5a, 0x02, 0x9a, 0x65, 0xf8, 0xef, 0xef, 0xd1, 0x87, 0x8d, 0x6c,
+		0xcb, 0x60, 0x07, 0xd8, 0x6d, 0xbb, 0xc9, 0x45, 0x6b, 0x2c, 0xe9, 0x7c,
+		0x3d, 0xe7, 0x1c, 0xc9, 0xf2, 0x91, 0x57, 0x2b, 0x34, 0x26, 0x13, 0x2f,
+		0x20, 0xe8, 0x24, 0x8a, 0xc3, 0x05, 0x09, 0x70, 0xe0, 0x92, 0x13, 0xb4,
+		0x5e, 0x9f, 0x9d, 0xa1, 0xe1, 0x3b, 0x67, 0x80, 0xae, 0x9c, 0x5e, 0x17,
+		0x7d, 0xea, 0x0c, 0x50, 0xe7, 0x7e, 0x78, 0x73, 0xdd, 0xed, 0x77, 0xef,
+		0x3a, 0xc3, 0xee, 0x25, 0xfa, 0x0d, 0x75, 0xfa, 0x7f, 0xa2, 0xee, 0xa5,
+		0x33, 0x1c, 0xa0, 0xe1, 0x8d, 0xec, 0xfa, 0xc9, 0xe9, 0xf5, 0xd0, 0xdb,
+		0x2e, 0xea, 0xdd, 0x0c, 0x86, 0xe8, 0xd3, 0xbb, 0x6e, 0x1f, 0x39, 0x43,
+		0x04, 0xf7, 0xef, 0xba, 0xe9, 0xb8, 0x06, 0x90, 0xdd, 0x10, 0xb9, 0x1f,
+		0x38, 0xfd, 0x6b, 0xf4, 0x47, 0xf7, 0xcf, 0xc1, 0x6d, 0xe7, 0xa2, 0x8b,
+		0x56, 0x2b, 0x64, 0xdf, 0xa6, 0x42, 0xd8, 0x7f, 0x90, 0x27, 0x1a, 0x61,
+		0x97, 0x80, 0x30, 0x7c, 0x58, 0x67, 0x98, 0xef, 0x30, 0xf4, 0x66, 0x84,
+		0x32, 0x3c, 0x8b, 0xa0, 0x87, 0xa2, 0xe5, 0x7e, 0xf5, 0x5d, 0xf4, 0xb1,
+		0x7b, 0x37, 0x70, 0x6e, 0xfa, 0xf9, 0xee, 0x1f, 0x49, 0x4c, 0xbd, 0x30,
+		0x48, 0xc8, 0x5d, 0x77, 0x9c, 0x3e, 0xc8, 0xf9, 0x8e, 0x0b, 0xeb, 0x5c,
+		0xe6, 0x3b, 0xbf, 0x0b, 0x29, 0x73, 0xc6, 0x9c, 0x70, 0x73, 0xd0, 0xbd,
+		0x03, 0x8a, 0x65, 0x54, 0x07, 0x24, 0x5e, 0x90, 0xf8, 0x8e, 0xf8, 0x04,
+		0x53, 0x2e, 0xaa, 0xc5, 0x89, 0x5f, 0xf4, 0x9c, 0x6e, 0x7f, 0x88, 0xfa,
+		0xdd, 0xeb, 0x9b, 0xa1, 0x23, 0x54, 0xbd, 0xf8, 0xd0, 0x2b, 0xa3, 0xd0,
+		0x27, 0x0f, 0x21, 0xf3, 0x30, 0x23, 0x63, 0xde, 0x49, 0xe3, 0x38, 0xb8,
+		0xbf, 0xbd, 0xbd, 0xb9, 0x03, 0x03, 0xdf, 0xdf, 0x72, 0x1b, 0x1b, 0x19,
+		0xcb, 0x21, 0x82, 0xe9, 0xe0, 0xe2, 0x5d, 0xf7, 0x7d, 0x07, 0x00, 0xeb,
+		0x5f, 0x77, 0xef, 0x6e, 0xef, 0x9c, 0x7e, 0xc1, 0x60, 0x57, 0x5e, 0xf0,
+		0x40, 0xe2, 0x28, 0xf6, 0x02, 0xc6, 0xad, 0x00, 0xad, 0x24, 0xe0, 0x3a,
+		0x36, 0xe0, 0xf2, 0xec, 0x14, 0x0d, 0xa7, 0x04, 0x51, 0x77, 0x4a, 0x66,
+		0x18, 0xb1, 0x29, 0x66, 0xf0, 0x0f, 0x41, 0x6e, 0x38, 0x26, 0x68, 0x89,
+		0x29, 0x7a, 0x20, 0x01, 0x89, 0xb9, 0x90, 0x68, 0x12, 0x87, 0xb3, 0x16,
+		0x5a, 0x4e, 0x3d, 0x77, 0x2a, 0xed, 0x0d, 0x43, 0xdc, 0x47, 0xe8, 0x39,
+		0x8b, 0x70, 0x4c, 0x28, 0x5a, 0x7a, 0x6c, 0x2a, 0xc7, 0xce, 0xe3, 0x98,
+		0x00, 0x2b, 0x45, 0xf3, 0xf4, 0x4c, 0xf2, 0x4c, 0xfc, 0x4d, 0xde, 0xe6,
+		0xbe, 0xb6, 0x5a, 0xc5, 0x18, 0x24, 0x43, 0xaf, 0xfe, 0x6a, 0xa1, 0x57,
+		0x6e, 0xe8, 0xa3, 0xf6, 0x39, 0xb2, 0x07, 0xa2, 0x59, 0x38, 0xa2, 0xd2,
+		0x0c, 0xc6, 0x8a, 0x56, 0x41, 0x06, 0x24, 0xe7, 0x03, 0x35, 0x0d, 0x52,
+		0xc2, 0xde, 0x2c, 0x0a, 0x63, 0x46, 0x39, 0xe5, 0x86, 0xbc, 0x46, 0xcd,
+		0x06, 0x82, 0xbf, 0x0c, 0x9f, 0x08, 0x83, 0x98, 0x9c, 0x91, 0x23, 0xbb,
+		0x43, 0x67, 0xa4, 0xfe, 0x4e, 0x56, 0x2b, 0xd1, 0xbc, 0x5e, 0x9f, 0xa8,
+		0x71, 0x82, 0x5b, 0xc3, 0x6a, 0x98, 0x19, 0x2e, 0xa4, 0x67, 0x09, 0x86,
+		0x6e, 0x18, 0xd0, 0x84, 0x1f, 0x80, 0x73, 0xf1, 0x57, 0x82, 0xfa, 0x39,
+		0x27, 0x5b, 0xe2, 0x90, 0x27, 0x79, 0xd2, 0x09, 0x1a, 0x53, 0xb0, 0x28,
+		0x58, 0xdc, 0xf3, 0x09, 0x0a, 0x27, 0x08, 0x23, 0x1a, 0xf9, 0x1e, 0x43,
+		0xe1, 0x9c, 0x45, 0x73, 0x96, 0x60, 0x30, 0x0d, 0xfd, 0x31, 0x45, 0x04,
+		0x84, 0x78, 0x62, 0x53, 0x40, 0x58, 0x82, 0xe7, 0x51, 0x14, 0x84, 0x60,
+		0xfb, 0x88, 0xb8, 0xde, 0xc4, 0x73, 0x11, 0x0b, 0x61, 0x38, 0xc3, 0x23,
+		0xa0, 0x54, 0x00, 0x42, 0x70, 0x91, 0x40, 0x20, 0x46, 0x66, 0x91, 0x0f,
+		0x38, 0x67, 0xf3, 0x81, 0x9d, 0x6f, 0x4c, 0xc0, 0xb3, 0x85, 0xc0, 0x10,
+		0xa6, 0x8f, 0x18, 0x0c, 0xcb, 0x15, 0xbc, 0x89, 0x18, 0x28, 0x45, 0xed,
+		0x5b, 0x75, 0x4f, 0xd9, 0x6a, 0x33, 0x34, 0xb5, 0x96, 0x6d, 0x72, 0xc2,
+		0x0e, 0xa2, 0xa0, 0x03, 0x48, 0x29, 0x65, 0x35, 0x69, 0xcd, 0xfd, 0x2b,
+		0x0c, 0xfc, 0x27, 0xe1, 0x64, 0x0a, 0xec, 0x8d, 0xc7, 0xca, 0x71, 0x01,
+		0x21, 0x60, 0x94, 0xbc, 0xa6, 0xa2, 0xed, 0x0a, 0xac, 0xb9, 0x53, 0xd9,
+		0x9a, 0x3a, 0xa5, 0x2e, 0x67, 0x17, 0xdb, 0x04, 0x53, 0x93, 0xb6, 0x59,
+		0xb1, 0x84, 0xf7, 0x48, 0x6f, 0x03, 0x37, 0x9f, 0x08, 0xd7, 0x1c, 0x0a,
+		0x5d, 0xb4, 0xfb, 0x13, 0x8f, 0x70, 0xb0, 0x79, 0xdb, 0x95, 0xbc, 0xd4,
+		0x1a, 0x17, 0xd8, 0x9f, 0x13, 0xd9, 0xf8, 0x51, 0x5e, 0xea, 0x14, 0x43,
+		0x7f, 0x3e, 0x0b, 0x64, 0xeb, 0x85, 0xba, 0xd6, 0x9a, 0x83, 0xb9, 0xef,
+		0x0b, 0x66, 0xbc, 0xbd, 0x9f, 0xfc, 0xd0, 0x3a, 0xcc, 0x70, 0x14, 0x81,
+		0x2b, 0xf2, 0xe6, 0xf7, 0xf2, 0x52, 0x6b, 0x64, 0xf8, 0x81, 0x2a, 0x81,
+		0x1f, 0x32, 0x64, 0xe9, 0xd3, 0x6c, 0x94, 0xc4, 0xb3, 0xbc, 0x2c, 0x34,
+		0xf6, 0xf1, 0x8c, 0xe8, 0x62, 0xc9, 0x7e, 0x06, 0x2a, 0x54, 0x23, 0x93,
+		0x69, 0x7e, 0x4c, 0x26, 0x0a, 0xde, 0x5e, 0x32, 0x81, 0xa4, 0x36, 0xf2,
+		0xc8, 0x52, 0x99, 0x48, 0x5c, 0x71, 0x24, 0xd2, 0xc6, 0x01, 0x8b, 0xe7,
+		0x2e, 0x1b, 0x3e, 0x45, 0x92, 0x94, 0xb8, 0xd0, 0xc6, 0x7a, 0x94, 0x8f,
+		0xe1, 0x4d, 0x5e, 0x30, 0x26, 0xdf, 0x12, 0x62, 0x00, 0x96, 0xcd, 0x95,
+		0x10, 0xb4, 0x0a, 0x69, 0x46, 0xa5, 0x33, 0xde, 0x49, 0x99, 0x5d, 0x4b,
+		0x34, 0x9c, 0x2a, 0xdc, 0xcd, 0xf2, 0x55, 0xc4, 0x15, 0xd4, 0x9c, 0x40,
+		0x4a, 0x5e, 0x1f, 0xf7, 0x61, 0x8e, 0x7d, 0x88, 0x6c, 0x98, 0x35, 0xf2,
+		0x04, 0xa8, 0xcc, 0xf0, 0xba, 0x3a, 0x39, 0x2e, 0x1a, 0x29, 0xc6, 0x7f,
+		0x43, 0xbe, 0x33, 0x93, 0x5b, 0xaf, 0xa5, 0xd0, 0x88, 0x8a, 0x5b, 0xab,
+		0x74, 0x98, 0x92, 0xc2, 0x9b, 0x20, 0x3a, 0x8f, 0x84, 0xef, 0x5f, 0xf8,
+		0x73, 0xca, 0x48, 0xcc, 0x13, 0x50, 0x92, 0xa5, 0xf5, 0xce, 0x63, 0x42,
+		0x5d, 0x34, 0x0a, 0x43, 0x3f, 0x4f, 0x42, 0xc5, 0x43, 0x72, 0x47, 0xd9,
+		0x90, 0xff, 0x4d, 0xe6, 0x81, 0x8b, 0x9a, 0x23, 0x74, 0x5a, 0x41, 0x3e,
+		0x0b, 0xc9, 0x0b, 0x6e, 0xa9, 0xa6, 0xc5, 0xc5, 0xe5, 0x82, 0x64, 0xe5,
+		0x8d, 0x09, 0x9b, 0xc7, 0x81, 0xc8, 0xee, 0x89, 0x51, 0x93, 0x0c, 0xbf,
+		0x1f, 0xe7, 0x0f, 0x3d, 0x7e, 0x67, 0x37, 0x5b, 0x98, 0x2d, 0x25, 0x1a,
+		0x9c, 0xbd, 0x24, 0x62, 0x64, 0x2f, 0x0d, 0xeb, 0xd1, 0x9e, 0x47, 0x59,
+		0x3a, 0x20, 0x75, 0xaf, 0xe7, 0x09, 0x99, 0xd0, 0x02, 0x29, 0xf9, 0xa4,
+		0xad, 0x9c, 0x31, 0x27, 0xaa, 0x26, 0xee, 0xeb, 0x2d, 0x3e, 0x96, 0x90,
+		0x5d, 0x65, 0x21, 0xce, 0x6a, 0x90, 0xe4, 0xb9, 0xe7, 0x89, 0x3b, 0x0c,
+		0x9b, 0x22, 0x9d, 0xa1, 0xd3, 0xd5, 0x2a, 0x89, 0x36, 0x99, 0xd3, 0x34,
+		0xec, 0x32, 0xaa, 0xbc, 0x85, 0x6e, 0xa5, 0xc6, 0x2f, 0xf6, 0x5b, 0xc9,
+		0x5f, 0x6d, 0x34, 0x6a, 0x21, 0x91, 0x2d, 0xdb, 0x48, 0x30, 0x58, 0x9b,
+		0x01, 0x51, 0x13, 0xb2, 0x58, 0xef, 0x50, 0xc2, 0xc0, 0xd1, 0xa9, 0x9a,
+		0x83, 0xf0, 0x23, 0x41, 0xa3, 0xb9, 0xe7, 0xc3, 0x94, 0x1b, 0x48, 0x12,
+		0x22, 0xa6, 0x68, 0x0b, 0x51, 0x3e, 0xe5, 0xaa, 0xbc, 0x29, 0xd3, 0x2f,
+		0x1a, 0x01, 0x73, 0x8a, 0x3c, 0x98, 0xaf, 0xc2, 0xa5, 0xea, 0xad, 0x66,
+		0xa9, 0x1c, 0xf6, 0x52, 0x65, 0x35, 0xd8, 0x98, 0x03, 0xea, 0xdb, 0x74,
+		0x40, 0x98, 0x32, 0xea, 0x7e, 0x36, 0xdd, 0xd3, 0xae, 0xd2, 0xb6, 0x5b,
+		0x3c, 0x45, 0x9a, 0x60, 0x8a, 0xe9, 0x80, 0xc0, 0x6a, 0x6a, 0x8c, 0xe3,
+		0x27, 0x47, 0x4a, 0x6b, 0x48, 0x2b, 0xf5, 0x2c, 0xd0, 0xfd, 0x5a, 0xc3,
+		0x00, 0xa0, 0x0e, 0x9f, 0xf5, 0x0d, 0xca, 0x2b, 0x30, 0x21, 0xd5, 0xbe,
+		0xde, 0xc9, 0x35, 0x17, 0x23, 0xfc, 0x6f, 0xa4, 0xac, 0x0a, 0xe3, 0xb7,
+		0xd8, 0x4f, 0x32, 0xd9, 0x66, 0xc4, 0x22, 0x0e, 0x4a, 0xe4, 0x95, 0xa2,
+		0xd5, 0x4e, 0x58, 0xb5, 0xd0, 0x2d, 0xac, 0xf0, 0x3c, 0x17, 0xd6, 0x23,
+		0x6d, 0xd9, 0xb7, 0xfb, 0x35, 0xbd, 0x53, 0x1b, 0x19, 0x8f, 0xc2, 0x12,
+		0x0a, 0x22, 0x40, 0x2c, 0x11, 0xfe, 0x51, 0x50, 0x24, 0xdf, 0x1f, 0x10,
+		0x9a, 0x9c, 0xe0, 0xab, 0xf4, 0xaa, 0xbd, 0x1f, 0x74, 0x65, 0xd8, 0xd5,
+		0x47, 0xe1, 0x9a, 0xbd, 0xa0, 0x50, 0x07, 0x85, 0x6b, 0x76, 0x14, 0x14,
+		0xc8, 0x0b, 0x0a, 0xb5, 0x50, 0x20, 0xc7, 0x40, 0xa1, 0xf7, 0x12, 0x0b,
+		0xb5, 0x50, 0xe8, 0x1d, 0x25, 0x16, 0x7a, 0x2f, 0xb1, 0x50, 0x0f, 0x85,
+		0x0a, 0xb1, 0xb0, 0x65, 0x4e, 0x1f, 0x30, 0xcc, 0x3c, 0x57, 0x59, 0x7f,
+		0xff, 0x59, 0xdd, 0x51, 0x04, 0xe1, 0x79, 0x83, 0x3f, 0xf5, 0x95, 0x2f,
+		0x21, 0x61, 0x30, 0xa9, 0x2f, 0xed, 0x45, 0x38, 0x0f, 0x84, 0xe5, 0x0e,
+		0x24, 0xee, 0x05, 0x0e, 0x9c, 0xc0, 0x8d, 0xc9, 0x8c, 0x04, 0x6c, 0x5f,
+		0x91, 0x7d, 0x5a, 0x58, 0xa8, 0x67, 0x1f, 0x95, 0x6f, 0x71, 0xcc, 0x04,
+		0xaa, 0x65, 0x0f, 0xcb, 0x9b, 0x21, 0x7c, 0x0f, 0xaf, 0x69, 0x5e, 0x0a,
+		0x5b, 0xa6, 0x51, 0xff, 0xda, 0x9a, 0xf8, 0x40, 0x81, 0x76, 0x8c, 0x80,
+		0x3b, 0xda, 0x5a, 0xd9, 0x9c, 0xe5, 0xcc, 0xfb, 0x1a, 0xcf, 0x03, 0x27,
+		0xf5, 0x94, 0xb7, 0x4f, 0x3b, 0x1f, 0xdc, 0x35, 0x15, 0x47, 0x8d, 0x4a,
+		0x22, 0xca, 0x4d, 0x06, 0x4c, 0xd9, 0x45, 0x38, 0x8b, 0xc2, 0x80, 0xef,
+		0xd5, 0x0b, 0x6f, 0xe4, 0xdb, 0x8f, 0x07, 0xf1, 0x2d, 0x27, 0x50, 0xbe,
+		0x65, 0xdb, 0xf6, 0x8b, 0x7b, 0x95, 0xb8, 0x97, 0x13, 0x1c, 0xc8, 0xbd,
+		0x4a, 0xef, 0x6e, 0xdf, 0xa5, 0xdb, 0xd3, 0x45, 0x15, 0xc5, 0x4f, 0x1e,
+		0x9b, 0x96, 0xed, 0x80, 0xe5, 0xbd, 0xd3, 0xd6, 0xf7, 0xea, 0x0c, 0xae,
+		0xba, 0xa7, 0x44, 0x97, 0x84, 0xba, 0x69, 0xb4, 0x48, 0xe9, 0x44, 0xe7,
+		0x9d, 0x61, 0x53, 0xc1, 0xa5, 0xf8, 0x36, 0x66, 0x5b, 0x4c, 0x00, 0xeb,
+		0xc3, 0x0b, 0xee, 0x50, 0x2e, 0x3a, 0x11, 0x7e, 0x52, 0x3e, 0x09, 0x65,
+		0x2c, 0xc9, 0xe5, 0xa9, 0x22, 0xc8, 0xcb, 0x6c, 0xf2, 0x53, 0xcc, 0x26,
+		0x2f, 0x59, 0xfc, 0xa7, 0xcf, 0xe2, 0x47, 0xdd, 0xba, 0xd9, 0x05, 0xce,
+		0x01, 0x80, 0x39, 0x34, 0x28, 0xcf, 0x05, 0x44, 0xdf, 0xa0, 0xa9, 0xb0,
+		0x1c, 0x3a, 0xda, 0x3e, 0xcd, 0x7f, 0xc9, 0xe4, 0xe4, 0x88, 0x26, 0xef,
+		0xbd, 0x78, 0xf9, 0x8e, 0xad, 0x97, 0xc3, 0x9b, 0xfc, 0xc5, 0xcb, 0x77,
+		0xec, 0xb3, 0xd4, 0x78, 0xce, 0xe2, 0xd5, 0x6a, 0xf7, 0xf7, 0xce, 0x65,
+		0xe9, 0x03, 0x7f, 0x7d, 0x7c, 0x3a, 0x13, 0x58, 0xda, 0x2a, 0x88, 0x18,
+		0x90, 0x17, 0x15, 0x71, 0x3f, 0xc8, 0x34, 0x2c, 0x7a, 0xbe, 0xc7, 0xdf,
+		0x12, 0xb3, 0x48, 0x35, 0xac, 0xc3, 0x4f, 0xcc, 0x5b, 0xe7, 0x01, 0x33,
+		0x64, 0xf5, 0x91, 0x78, 0x4b, 0x26, 0x61, 0x4c, 0x7e, 0x68, 0x28, 0xbc,
+		0xe0, 0xe8, 0x50, 0xf4, 0xfe, 0x19, 0x28, 0xd8, 0x92, 0x90, 0xa0, 0x29,
+		0xcb, 0x14, 0x59, 0xf8, 0xaf, 0xc2, 0x11, 0xce, 0x03, 0x59, 0xae, 0xf5,
+		0xf9, 0x8b, 0xc7, 0xf7, 0x2b, 0x27, 0xd8, 0x25, 0xab, 0xf5, 0xaa, 0x60,
+		0x71, 0x2e, 0xac, 0xd5, 0x2a, 0x06, 0x05, 0x0b, 0xad, 0x03, 0x83, 0x2d,
+		0x45, 0x3a, 0x3c, 0xb6, 0xca, 0xec, 0x47, 0xdb, 0xca, 0xc8, 0x3d, 0x03,
+		0x25, 0x25, 0x99, 0x0d, 0xbd, 0x86, 0x49, 0xc7, 0x46, 0x55, 0x2c, 0x69,
+		0x18, 0x57, 0x2f, 0xcb, 0x92, 0xbd, 0x4b, 0xea, 0xb0, 0x80, 0x74, 0xa6,
+		0x46, 0x23, 0xad, 0x9c, 0xd3, 0xab, 0x34, 0x4e, 0x53, 0x71, 0xb7, 0x4c,
+		0x9d, 0x1b, 0x1e, 0xa2, 0x7e, 0x4e, 0x6b, 0xd1, 0x64, 0x96, 0x6a, 0x6a,
+		0x4f, 0xf5, 0xd5, 0xd5, 0x90, 0x71, 0x43, 0x65, 0xdc, 0xe8, 0xb6, 0xb1,
+		0xb6, 0x69, 0x27, 0xfc, 0xa4, 0x69, 0x1d, 0x4e, 0x49, 0x53, 0x3d, 0x0e,
+		0xb5, 0xb7, 0x48, 0xd0, 0xc8, 0x7a, 0xcb, 0x4e, 0xa8, 0x2f, 0xc9, 0xe4,
+		0x60, 0x68, 0xeb, 0x35, 0x89, 0x46, 0xa8, 0x92, 0x3a, 0xca, 0x8a, 0x68,
+		0x09, 0x0c, 0x78, 0xf4, 0xe4, 0x64, 0x6e, 0x7a, 0x90, 0x0d, 0xd0, 0xe9,
+		0x43, 0x08, 0xc1, 0x63, 0x3b, 0x70, 0x6d, 0xa1, 0xe6, 0xe7, 0x2f, 0xb9,
+		0x4e, 0x2d, 0x44, 0xe2, 0x38, 0x84, 0xa6, 0x8d, 0x5a, 0x38, 0x8e, 0xf1,
+		0x13, 0xd7, 0x65, 0x86, 0x1f, 0x89, 0x69, 0xc4, 0x9b, 0xcd, 0x86, 0x1a,
+		0x0c, 0xe6, 0x3d, 0xdf, 0xe3, 0xc8, 0xc4, 0xbc, 0x25, 0x64, 0x6b, 0xb2,
+		0xa2, 0x73, 0x34, 0xf9, 0xc6, 0x93, 0x81, 0xf7, 0x86, 0xff, 0x39, 0xe2,
+		0x85, 0x49, 0xc1, 0xb8, 0x29, 0x7e, 0x42, 0x96, 0xb5, 0x1a, 0x25, 0xaf,
+		0x49, 0x5a, 0x28, 0xf0, 0x36, 0x25, 0x7f, 0xeb, 0x4d, 0x3f, 0xd5, 0x47,
+		0x11, 0x00, 0x5e, 0x05, 0xab, 0x95, 0xc8, 0xad, 0x1b, 0x0d, 0x32, 0x26,
+		0xf6, 0xfd, 0x11, 0x76, 0x1f, 0xab, 0x29, 0x63, 0xc9, 0xff, 0x35, 0x9d,
+		0xb4, 0xb2, 0x58, 0x4e, 0x3c, 0x71, 0x0f, 0x6d, 0x5b, 0x32, 0x0e, 0x97,
+		0x9a, 0xbd, 0xb5, 0x2c, 0xde, 0x42, 0x3e, 0x4c, 0x33, 0x8a, 0x80, 0x65,
+		0x69, 0x95, 0x6e, 0x19, 0x0e, 0xc2, 0x6b, 0x39, 0x85, 0x9c, 0x68, 0xab,
+		0x7c, 0x39, 0x1f, 0x8c, 0xf2, 0x78, 0xbf, 0x37, 0xbf, 0xc3, 0xff, 0xff,
+		0xcb, 0x10, 0x87, 0x3b, 0xbf, 0xfe, 0x6a, 0x98, 0xae, 0xe8, 0xd2, 0x63,
+		0xbc, 0x0a, 0x5f, 0x76, 0xfb, 0xec, 0x7d, 0x91, 0xa1, 0xb9, 0x32, 0x64,
+		0xd9, 0x3a, 0xd1, 0x90, 0x1a, 0x87, 0x1f, 0x69, 0xc8, 0x95, 0x4b, 0xb6,
+		0xb9, 0x41, 0x80, 0x13, 0xf8, 0xc0, 0x6b, 0x56, 0x29, 0x8a, 0x4d, 0xd1,
+		0x91, 0xad, 0x0c, 0x9d, 0xe0, 0xb9, 0xcf, 0xda, 0x46, 0x11, 0xfc, 0xf0,
+		0xc1, 0xbe, 0xc2, 0x0c, 0xfb, 0xcd, 0x93, 0x79, 0x30, 0xc5, 0xc1, 0xd8,
+		0x4f, 0x8b, 0xe9, 0xda, 0xe8, 0xa4, 0x95, 0x57, 0xdd, 0xda, 0xb1, 0x20,
+		0xcf, 0xfe, 0x82, 0xfc, 0xf6, 0x8b, 0x40, 0x7d, 0xe0, 0xe2, 0xa0, 0x09,
+		0x7a, 0xd9, 0xb6, 0x6d, 0x19, 0xac, 0x37, 0x8a, 0x09, 0x7e, 0x6c, 0x6c,
+		0xd9, 0x61, 0x85, 0xf6, 0x71, 0x9f, 0x7c, 0x63, 0xad, 0x24, 0xe6, 0x12,
+		0xb7, 0x6c, 0xe6, 0x22, 0x03, 0x38, 0xf2, 0x1e, 0xbf, 0x9c, 0xf3, 0xb0,
+		0x28, 0x7f, 0xc1, 0x98, 0x84, 0x43, 0xb9, 0xd8, 0x09, 0xc7, 0x72, 0x1a,
+		0x7a, 0xdc, 0x65, 0x69, 0x68, 0xc2, 0xe7, 0xfa, 0xa6, 0x53, 0x0c, 0xe7,
+		0x91, 0xd4, 0x3b, 0x6b, 0x90, 0x9d, 0x9d, 0x21, 0x47, 0xde, 0x9c, 0xe1,
+		0xf8, 0x91, 0x72, 0x97, 0x56, 0xc5, 0xcf, 0x90, 0x22, 0x31, 0x15, 0xe5,
+		0x8e, 0xfc, 0xed, 0x02, 0x2c, 0x97, 0xfe, 0x06, 0x9c, 0x78, 0x7d, 0x74,
+		0x7a, 0x52, 0x04, 0x07, 0xb2, 0x60, 0x72, 0x44, 0x84, 0xb9, 0xec, 0xdd,
+		0xd3, 0x14, 0xcf, 0xeb, 0x96, 0x62, 0x68, 0xde, 0x0e, 0x37, 0xbd, 0x8f,
+		0x4d, 0xeb, 0xb5, 0xf5, 0xf7, 0xb0, 0xc6, 0xd7, 0xc6, 0x57, 0x78, 0xe6,
+		0x81, 0x40, 0xb9, 0x7d, 0xd2, 0x9d, 0xe2, 0x28, 0x1a, 0xa2, 0x36, 0xbf,
+		0x8e, 0x58, 0xba, 0x15, 0x3b, 0x51, 0xe4, 0x3f, 0x29, 0x42, 0x97, 0xc4,
+		0x67, 0x58, 0x55, 0x86, 0xf2, 0x03, 0x0c, 0x63, 0x6f, 0x32, 0x21, 0x31,
+		0x09, 0x5c, 0x02, 0xb6, 0x12, 0x8b, 0x2a, 0xc4, 0x96, 0x21, 0xa2, 0x01,
+		0x8e, 0xe8, 0x34, 0xe4, 0xa5, 0xa3, 0xfc, 0x4c, 0x04, 0x78, 0x6b, 0x6b,
+		0x73, 0xd4, 0xe6, 0x91, 0xc8, 0x4a, 0xd4, 0x40, 0x9c, 0xce, 0xd1, 0x39,
+		0xf1, 0xd6, 0x79, 0x34, 0x16, 0x27, 0x77, 0x12, 0x12, 0x08, 0xe2, 0x28,
+		0x39, 0x41, 0x11, 0x52, 0x7e, 0xc8, 0x47, 0x08, 0xa2, 0x0e, 0x51, 0x4c,
+		0xf1, 0x02, 0x6e, 0x4d, 0x79, 0xbe, 0xa8, 0x8e, 0x52, 0x41, 0xa1, 0x66,
+		0xe8, 0x8f, 0x5b, 0x29, 0xe7, 0x42, 0x5a, 0x16, 0x6b, 0x46, 0x61, 0x42,
+		0x73, 0x8d, 0xa9, 0xab, 0xea, 0xfd, 0x3f, 0x7f, 0x31, 0x2c, 0x6d, 0xd7,
+		0xcf, 0x9e, 0xe5, 0x9f, 0x57, 0x6e, 0x7f, 0xe0, 0x92, 0xfb, 0x7a, 0x75,
+		0x0c, 0xd0, 0x6d, 0x2c, 0x3c, 0x04, 0xd8, 0x28, 0x73, 0xda, 0x45, 0x45,
+		0x20, 0xfe, 0x7e, 0x43, 0x60, 0x72, 0x63, 0xd3, 0xef, 0x8a, 0x02, 0xa4,
+		0x9d, 0x37, 0xe6, 0xda, 0x18, 0xba, 0x99, 0xd2, 0xf9, 0xaf, 0x56, 0xb2,
+		0xfe, 0xd7, 0xf0, 0xac, 0x56, 0x10, 0xde, 0x92, 0xac, 0x2c, 0x6b, 0x4b,
+		0xfe, 0x2a, 0xa9, 0x91, 0xd8, 0x22, 0x86, 0xe9, 0xb9, 0xa6, 0xaa, 0x3c,
+		0xea, 0xb9, 0x67, 0x9b, 0xe9, 0xd6, 0xd6, 0xce, 0x87, 0x8f, 0xe2, 0xd4,
+		0xa5, 0x3f, 0x28, 0xe9, 0x8e, 0xbc, 0x12, 0x3f, 0x84, 0x7c, 0xf9, 0x38,
+		0xe1, 0xe2, 0x28, 0xcf, 0x14, 0xcf, 0x65, 0x74, 0x6d, 0xce, 0x5a, 0xd5,
+		0xb3, 0xd1, 0x40, 0xbd, 0xd6, 0xbd, 0x8f, 0x28, 0x89, 0xd9, 0x73, 0x92,
+		0x64, 0xa0, 0x1f, 0xe4, 0xd1, 0x9f, 0xb0, 0x76, 0x70, 0x16, 0x5a, 0x96,
+		0x9d, 0xb6, 0xd0, 0x4f, 0x5a, 0x4c, 0x32, 0x07, 0x2d, 0xaa, 0x11, 0x4f,
+		0x4e, 0xee, 0xec, 0xa0, 0x9d, 0x1c, 0xfc, 0xc9, 0x11, 0xdf, 0xbc, 0xeb,
+		0xcc, 0x4d, 0x61, 0x3b, 0xb8, 0x72, 0x00, 0x9b, 0x8b, 0x7a, 0xb9, 0x6a,
+		0x6b, 0x9e, 0x7a, 0x7e, 0x9a, 0x4a, 0xde, 0xd5, 0x96, 0x3e, 0x7e, 0x59,
+		0xe8, 0xfb, 0xcd, 0x6d, 0x87, 0x0b, 0xda, 0xc5, 0xb6, 0x05, 0xe6, 0xba,
+		0x55, 0x33, 0x6a, 0xd7, 0x7b, 0xe1, 0xb1, 0xed, 0x49, 0xf8, 0x7b, 0x45,
+		0x82, 0xaf, 0x34, 0xc4, 0x4a, 0x53, 0xf0, 0xe5, 0x67, 0x42, 0x7d, 0x32,
+		0x11, 0x27, 0x29, 0xc5, 0xe9, 0x14, 0x31, 0xdd, 0x27, 0xbb, 0x48, 0x1e,
+		0x43, 0xe3, 0x90, 0xc8, 0x53, 0xa3, 0xcb, 0x18, 0x96, 0xc5, 0xfc, 0xbc,
+		0x68, 0x38, 0x1b, 0x51, 0x16, 0x06, 0x24, 0x3f, 0x23, 0x2d, 0xcc, 0x53,
+		0x50, 0xe9, 0xba, 0xf6, 0xb8, 0xb9, 0x7d, 0x51, 0x25, 0xab, 0xaf, 0x6b,
+		0x7a, 0xcb, 0xe1, 0xf2, 0xfb, 0xb3, 0xd2, 0x6b, 0x08, 0x99, 0xe8, 0xf4,
+		0x7b, 0x48, 0x45, 0x3f, 0x5f, 0x56, 0x79, 0x5d, 0x35, 0xad, 0x94, 0x27,
+		0x90, 0x43, 0x3a, 0x47, 0x15, 0x77, 0x90, 0x03, 0x2f, 0xf9, 0x79, 0x61,
+		0xb1, 0x21, 0x4b, 0x61, 0x66, 0xcc, 0xe0, 0x5c, 0x9c, 0x20, 0x33, 0xcd,
+		0xf9, 0x83, 0x9b, 0x75, 0x77, 0x03, 0xf6, 0xf1, 0x83, 0x23, 0xf8, 0x02,
+		0xff, 0xab, 0x08, 0x7a, 0x63, 0xdb, 0x16, 0x44, 0x1d, 0x08, 0xd2, 0x52,
+		0x4c, 0x58, 0x9c, 0xa4, 0xbb, 0x44, 0x89, 0x95, 0xd3, 0x46, 0x43, 0xa1,
+		0x59, 0x16, 0x8f, 0x42, 0xcf, 0x6a, 0xd0, 0xe8, 0xdc, 0x5f, 0xf0, 0x31,
+		0x86, 0x48, 0x5a, 0xd9, 0x58, 0x40, 0xa7, 0xbc, 0x08, 0x30, 0x17, 0x2b,
+		0xd9, 0x7e, 0x15, 0x83, 0x26, 0xcf, 0xf7, 0xbf, 0x0c, 0x4f, 0x0e, 0x19,
+		0x91, 0x0d, 0x01, 0x1e, 0x80, 0xe2, 0xd4, 0x00, 0x5a, 0x01, 0x07, 0x53,
+		0xda, 0xfc, 0xf9, 0x32, 0xd7, 0xce, 0x97, 0x0d, 0xed, 0xc3, 0xa3, 0xa3,
+		0xb6, 0xf4, 0x68, 0xf6, 0xeb, 0x09, 0xfc, 0x6f, 0x81, 0x63, 0xfd, 0x53,
+		0x0d, 0xc0, 0xfe, 0xbc, 0x1c, 0xc6, 0x86, 0xf1, 0x55, 0xdc, 0x0f, 0xf4,
+		0xb9, 0x83, 0x34, 0x89, 0x14, 0x3d, 0xcd, 0x42, 0xa6, 0x55, 0xa4, 0xd4,
+		0xa2, 0x69, 0x6d, 0x7d, 0x03, 0x64, 0x7e, 0xe2, 0xae, 0x79, 0x84, 0x3e,
+		0xfb, 0xb6, 0x2b, 0xfb, 0xa5, 0x90, 0xe3, 0x7f, 0x95, 0x65, 0xdb, 0x17,
+		0x4c, 0xb2, 0x5f, 0x6c, 0x69, 0x64, 0x00, 0x67, 0x9b, 0x0f, 0x95, 0xd0,
+		0xcd, 0x46, 0x42, 0xe1, 0xeb, 0x27, 0xaf, 0x98, 0xf6, 0xd9, 0x9e, 0xc6,
+		0xff, 0x01, 0x4a, 0xec, 0x64, 0x10, 0x76, 0x4a, 0x00, 0x00,
 	},
 		"generator/tmpl/binding.tmpl",
 	)
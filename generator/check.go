@@ -0,0 +1,457 @@
+package generator
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"github.com/gocql/gocql"
+	"github.com/relops/cqlc/cqlc"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrSchemaDrift = errors.New("generated code does not match the schema")
+)
+
+const (
+	fingerprintMarker = "// SCHEMA FINGERPRINT "
+	schemaMarker      = "// SCHEMA "
+	keyspaceMarker    = "// GENERATED USING KEYSPACE "
+)
+
+// SchemaColumn describes a column as it is recorded in the header of the generated code.
+type SchemaColumn struct {
+	Table  string
+	Column string
+	Type   string
+	Kind   string
+}
+
+func (c SchemaColumn) String() string {
+	return fmt.Sprintf("%s.%s %s %s", c.Table, c.Column, c.Type, c.Kind)
+}
+
+// keyspaceSchema describes the columns of each table of a keyspace, sorted by table and column.
+func keyspaceSchema(md *gocql.KeyspaceMetadata) []SchemaColumn {
+	cols := make([]SchemaColumn, 0)
+	for _, table := range md.Tables {
+		for _, col := range table.Columns {
+			cols = append(cols, SchemaColumn{
+				Table:  table.Name,
+				Column: col.Name,
+				Type:   cqlc.TypeName(col.Type),
+				Kind:   columnKind(*col),
+			})
+		}
+	}
+	sortSchema(cols)
+	return cols
+}
+
+func sortSchema(cols []SchemaColumn) {
+	sort.Sort(bySchemaColumn(cols))
+}
+
+type bySchemaColumn []SchemaColumn
+
+func (s bySchemaColumn) Len() int      { return len(s) }
+func (s bySchemaColumn) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s bySchemaColumn) Less(i, j int) bool {
+	if s[i].Table != s[j].Table {
+		return s[i].Table < s[j].Table
+	}
+	return s[i].Column < s[j].Column
+}
+
+// fingerprint identifies the schema of a keyspace, irrespective of when and by which cqlc version
+// the code was generated.
+func fingerprint(keyspace string, cols []SchemaColumn) string {
+	h := sha1.New()
+	io.WriteString(h, keyspace+"\n")
+	for _, col := range cols {
+		io.WriteString(h, col.String()+"\n")
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+func columnKind(c gocql.ColumnMetadata) string {
+	switch c.Kind {
+	case gocql.PARTITION_KEY:
+		return "partition_key"
+	case gocql.CLUSTERING_KEY:
+		return "clustering_key"
+	case gocql.STATIC:
+		return "static"
+	default:
+		return "regular"
+	}
+}
+
+// readSchema reads the keyspace, the fingerprint and the schema that are recorded in the header of generated code.
+func readSchema(path string) (keyspace, recorded string, cols []SchemaColumn, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", "", nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, keyspaceMarker):
+			keyspace = strings.TrimPrefix(line, keyspaceMarker)
+		case strings.HasPrefix(line, fingerprintMarker):
+			recorded = strings.TrimPrefix(line, fingerprintMarker)
+		case strings.HasPrefix(line, schemaMarker):
+			fields := strings.Fields(strings.TrimPrefix(line, schemaMarker))
+			if len(fields) != 3 || !strings.Contains(fields[0], ".") {
+				return "", "", nil, fmt.Errorf("Could not parse the schema of %s: %s", path, line)
+			}
+			name := strings.SplitN(fields[0], ".", 2)
+			cols = append(cols, SchemaColumn{Table: name[0], Column: name[1], Type: fields[1], Kind: fields[2]})
+		case strings.HasPrefix(line, "package "):
+			// The schema is recorded in the header only
+			if recorded == "" {
+				return "", "", nil, fmt.Errorf("No schema fingerprint in %s, which needs to be regenerated by this version of cqlc", path)
+			}
+			return keyspace, recorded, cols, nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", "", nil, err
+	}
+	return "", "", nil, fmt.Errorf("No schema fingerprint in %s, which needs to be regenerated by this version of cqlc", path)
+}
+
+var (
+	commentRegex = regexp.MustCompile(`(?s)--[^\n]*|//[^\n]*|/\*.*?\*/`)
+	createRegex  = regexp.MustCompile(`(?is)^\s*create\s+table\s+(?:if\s+not\s+exists\s+)?([\w."]+)\s*\(`)
+	viewRegex    = regexp.MustCompile(`(?is)^\s*create\s+materialized\s+view\s+(?:if\s+not\s+exists\s+)?([\w."]+)\s+as\s+select\s+(.+?)\s+from\s+([\w."]+)\s.*?\bprimary\s+key\s*\(`)
+	varcharRegex = regexp.MustCompile(`\bvarchar\b`)
+)
+
+// parseSchema reads the tables and materialized views that a CQL script creates, ignoring any other statements.
+func parseSchema(src string) ([]SchemaColumn, error) {
+	cols := make([]SchemaColumn, 0)
+	tables := make(map[string][]SchemaColumn)
+
+	for _, stmt := range strings.Split(commentRegex.ReplaceAllString(src, ""), ";") {
+		var name string
+		var tableCols []SchemaColumn
+		var err error
+
+		if m := createRegex.FindStringSubmatchIndex(stmt); m != nil {
+			name = schemaName(stmt[m[2]:m[3]])
+			tableCols, err = parseTable(name, stmt[m[1]-1:])
+		} else if m := viewRegex.FindStringSubmatchIndex(stmt); m != nil {
+			name = schemaName(stmt[m[2]:m[3]])
+			base, ok := tables[schemaName(stmt[m[6]:m[7]])]
+			if !ok {
+				return nil, fmt.Errorf("Unknown base table %s of view %s", stmt[m[6]:m[7]], name)
+			}
+			tableCols, err = parseView(name, stmt[m[4]:m[5]], stmt[m[1]-1:], base)
+		} else {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		tables[name] = tableCols
+		cols = append(cols, tableCols...)
+	}
+
+	sortSchema(cols)
+	return cols, nil
+}
+
+// schemaName strips the keyspace from the name of a table or view.
+func schemaName(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return cqlIdentifier(name)
+}
+
+// parseTable reads the column definitions and the primary key of a CREATE TABLE statement.
+func parseTable(table, definition string) ([]SchemaColumn, error) {
+	body, ok := enclosed(definition)
+	if !ok {
+		return nil, fmt.Errorf("Unbalanced parentheses in the definition of table %s", table)
+	}
+
+	cols := make([]SchemaColumn, 0)
+	var key string
+
+	for _, def := range splitTopLevel(body, ',') {
+		fields := strings.Fields(def)
+		if len(fields) == 0 {
+			continue
+		}
+		if strings.EqualFold(fields[0], "primary") {
+			key = strings.TrimSpace(def[strings.Index(def, "("):])
+			continue
+		}
+
+		col := SchemaColumn{Table: table, Column: cqlIdentifier(fields[0]), Kind: "regular"}
+		rest := fields[1:]
+		if n := len(rest); n >= 2 && strings.EqualFold(rest[n-2], "primary") && strings.EqualFold(rest[n-1], "key") {
+			rest = rest[:n-2]
+			key = "(" + fields[0] + ")"
+		}
+		if n := len(rest); n >= 1 && strings.EqualFold(rest[n-1], "static") {
+			rest = rest[:n-1]
+			col.Kind = "static"
+		}
+		col.Type = varcharRegex.ReplaceAllString(strings.ToLower(strings.Join(rest, "")), "text")
+
+		cols = append(cols, col)
+	}
+
+	return cols, applyKey(table, key, cols)
+}
+
+// parseView reads the columns of a CREATE MATERIALIZED VIEW statement, which take their types
+// from the base table and their kinds from the primary key of the view.
+func parseView(view, selection, key string, base []SchemaColumn) ([]SchemaColumn, error) {
+	selected := make(map[string]bool)
+	for _, name := range splitTopLevel(selection, ',') {
+		selected[cqlIdentifier(strings.TrimSpace(name))] = true
+	}
+
+	inner, _ := enclosed(key)
+	for _, name := range strings.FieldsFunc(inner, func(r rune) bool { return r == ',' || r == '(' || r == ')' }) {
+		selected[cqlIdentifier(strings.TrimSpace(name))] = true
+	}
+
+	cols := make([]SchemaColumn, 0)
+	for _, col := range base {
+		if selected["*"] || selected[col.Column] {
+			cols = append(cols, SchemaColumn{Table: view, Column: col.Column, Type: col.Type, Kind: "regular"})
+		}
+	}
+
+	return cols, applyKey(view, key, cols)
+}
+
+// applyKey sets the kinds of the columns that make up the primary key of a table or view.
+func applyKey(table, key string, cols []SchemaColumn) error {
+	index := make(map[string]int)
+	for i, col := range cols {
+		index[col.Column] = i
+	}
+
+	inner, ok := enclosed(key)
+	if !ok {
+		return fmt.Errorf("No primary key in the definition of table %s", table)
+	}
+
+	for i, component := range splitTopLevel(inner, ',') {
+		component = strings.TrimSpace(component)
+		kind := "clustering_key"
+		names := []string{component}
+		if i == 0 {
+			kind = "partition_key"
+			if partition, ok := enclosed(component); ok {
+				names = splitTopLevel(partition, ',')
+			}
+		}
+		for _, name := range names {
+			j, ok := index[cqlIdentifier(strings.TrimSpace(name))]
+			if !ok {
+				return fmt.Errorf("Unknown key column %s in the definition of table %s", name, table)
+			}
+			cols[j].Kind = kind
+		}
+	}
+
+	return nil
+}
+
+// enclosed returns the contents of the parenthesis that a string starts with.
+func enclosed(s string) (string, bool) {
+	if !strings.HasPrefix(s, "(") {
+		return "", false
+	}
+	depth := 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return s[1:i], true
+			}
+		}
+	}
+	return "", false
+}
+
+// splitTopLevel splits a string at each separator that is not nested in parentheses or angle brackets.
+func splitTopLevel(s string, sep rune) []string {
+	parts := make([]string, 0)
+	depth, last := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(', '<':
+			depth++
+		case ')', '>':
+			depth--
+		case sep:
+			if depth == 0 {
+				parts = append(parts, s[last:i])
+				last = i + 1
+			}
+		}
+	}
+	return append(parts, s[last:])
+}
+
+// cqlIdentifier folds unquoted identifiers to lower case, as Cassandra does.
+func cqlIdentifier(name string) string {
+	if strings.HasPrefix(name, `"`) && strings.HasSuffix(name, `"`) && len(name) > 1 {
+		return name[1 : len(name)-1]
+	}
+	return strings.ToLower(name)
+}
+
+// diffSchema describes the columns that have been added, removed or changed between two schemas.
+func diffSchema(old, current []SchemaColumn) []string {
+	key := func(c SchemaColumn) string {
+		return c.Table + "." + c.Column
+	}
+
+	before := make(map[string]SchemaColumn)
+	for _, col := range old {
+		before[key(col)] = col
+	}
+	after := make(map[string]SchemaColumn)
+	for _, col := range current {
+		after[key(col)] = col
+	}
+
+	diff := make([]string, 0)
+	for _, col := range old {
+		now, ok := after[key(col)]
+		switch {
+		case !ok:
+			diff = append(diff, fmt.Sprintf("- %s", col))
+		case now.Type != col.Type || now.Kind != col.Kind:
+			diff = append(diff, fmt.Sprintf("~ %s.%s %s %s -> %s %s", col.Table, col.Column, col.Type, col.Kind, now.Type, now.Kind))
+		}
+	}
+	for _, col := range current {
+		if _, ok := before[key(col)]; !ok {
+			diff = append(diff, fmt.Sprintf("+ %s", col))
+		}
+	}
+
+	sort.Strings(diff)
+	return diff
+}
+
+// Check compares the schema that generated code was generated from with the schema of a live keyspace,
+// or with the tables that a CQL file creates, and writes the differences to w.
+// ErrSchemaDrift is returned if the schemas differ.
+func Check(opts *Options, w io.Writer) error {
+
+	if err := LoadConfig(opts); err != nil {
+		return err
+	}
+
+	if (opts.Output == "") == (opts.Dir == "") {
+		return ErrInvalidOptions
+	}
+
+	path := opts.Output
+	if opts.Dir != "" {
+		path = filepath.Join(opts.Dir, sharedFile)
+	}
+
+	keyspace, recorded, generated, err := readSchema(path)
+	if err != nil {
+		return err
+	}
+
+	if opts.Keyspace == "" {
+		opts.Keyspace = keyspace
+	}
+
+	var current []SchemaColumn
+	if opts.Schema != "" {
+		src, err := ioutil.ReadFile(opts.Schema)
+		if err != nil {
+			return err
+		}
+		if current, err = parseSchema(string(src)); err != nil {
+			return err
+		}
+	} else {
+		if opts.Instance == "" || isMultiKeyspace(opts.Keyspace) {
+			return ErrInvalidOptions
+		}
+		s, _, err := connect(opts)
+		if err != nil {
+			return err
+		}
+		defer s.Close()
+
+		md, err := s.KeyspaceMetadata(opts.Keyspace)
+		if err != nil {
+			return err
+		}
+		current = keyspaceSchema(md)
+	}
+
+	// The generated code only covers the tables that the filters let through
+	if current, err = filterSchema(current, opts.Include, opts.Exclude); err != nil {
+		return err
+	}
+
+	if fingerprint(opts.Keyspace, current) == recorded {
+		return nil
+	}
+
+	if opts.Keyspace != keyspace {
+		fmt.Fprintf(w, "~ keyspace %s -> %s\n", keyspace, opts.Keyspace)
+	}
+	for _, line := range diffSchema(generated, current) {
+		fmt.Fprintln(w, line)
+	}
+
+	return ErrSchemaDrift
+}
+
+func filterSchema(cols []SchemaColumn, include, exclude []string) ([]SchemaColumn, error) {
+	tables := make(map[string]*gocql.TableMetadata)
+	for _, col := range cols {
+		tables[col.Table] = &gocql.TableMetadata{Name: col.Table}
+	}
+
+	if err := checkIncludes(include, tables); err != nil {
+		return nil, err
+	}
+
+	tables, err := filterTables(tables, include, exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]SchemaColumn, 0, len(cols))
+	for _, col := range cols {
+		if _, ok := tables[col.Table]; ok {
+			filtered = append(filtered, col)
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,155 @@
+package generator
+
+import (
+	"fmt"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"os"
+)
+
+// DefaultConfig is read from the working directory if no configuration file is given explicitly.
+const DefaultConfig = "cqlc.yaml"
+
+// Config is the contents of a generator configuration file, which allows the settings of a
+// generation run to be checked in alongside the generated code.
+// Any value that is also given as a command line flag is overridden by the flag,
+// and the switches can be turned off again with --no-symbols, --no-nullable and --no-initialisms.
+type Config struct {
+	Instance    string                 `yaml:"instance"`
+	Keyspace    string                 `yaml:"keyspace"`
+	Package     string                 `yaml:"package"`
+	Output      string                 `yaml:"output"`
+	Dir         string                 `yaml:"dir"`
+	Symbols     bool                   `yaml:"symbols"`
+	Nullable    bool                   `yaml:"nullable"`
+	Tags        []string               `yaml:"tags"`
+	TagCase     string                 `yaml:"tag_case"`
+	Initialisms bool                   `yaml:"initialisms"`
+	TypePrefix  string                 `yaml:"type_prefix"`
+	TypeSuffix  string                 `yaml:"type_suffix"`
+	Username    string                 `yaml:"username"`
+	Password    string                 `yaml:"password"`
+	Include     []string               `yaml:"include"`
+	Exclude     []string               `yaml:"exclude"`
+	Types       map[string]TypeMapping `yaml:"types"`
+	Tables      map[string]TableConfig `yaml:"tables"`
+}
+
+// TableConfig overrides the generated code for a single table.
+type TableConfig struct {
+	// Type is the name of the generated struct, which otherwise is the camel cased table name
+	Type string `yaml:"type"`
+	// Symbols overrides whether a compile symbol is generated for the table
+	Symbols *bool `yaml:"symbols"`
+	// Fields maps column names to the names of their struct fields
+	Fields map[string]string `yaml:"fields"`
+	// Types maps column names to Go types, which take precedence over the mappings of CQL types
+	Types map[string]TypeMapping `yaml:"types"`
+}
+
+// TypeMapping replaces the Go type that is generated for a column with a type of your own.
+// The type is handed to gocql as is, so it either needs to implement gocql.Marshaler and
+// gocql.Unmarshaler or have an underlying type that gocql can marshal for the CQL type of the column.
+type TypeMapping struct {
+	// Type is the Go type as it is written in the generated code, e.g. uuid.UUID or *Money
+	Type string `yaml:"type"`
+	// Import is the import path of a type that is defined in another package
+	Import string `yaml:"import"`
+}
+
+// LoadConfig reads the configuration file named in the options, or the default configuration
+// file if it exists, and merges it into any options that have not been set by flags.
+func LoadConfig(opts *Options) error {
+	path := opts.Config
+	if path == "" {
+		if _, err := os.Stat(DefaultConfig); err != nil {
+			switchOff(opts)
+			return nil
+		}
+		path = DefaultConfig
+	}
+
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(src, &cfg); err != nil {
+		return fmt.Errorf("Could not parse configuration file %s: %v", path, err)
+	}
+
+	mergeConfig(opts, &cfg)
+	return nil
+}
+
+func mergeConfig(opts *Options, cfg *Config) {
+	values := []struct {
+		flag  *string
+		value string
+	}{
+		{&opts.Instance, cfg.Instance},
+		{&opts.Keyspace, cfg.Keyspace},
+		{&opts.Package, cfg.Package},
+		{&opts.Output, cfg.Output},
+		{&opts.Dir, cfg.Dir},
+		{&opts.Username, cfg.Username},
+		{&opts.Password, cfg.Password},
+		{&opts.TagCase, cfg.TagCase},
+		{&opts.TypePrefix, cfg.TypePrefix},
+		{&opts.TypeSuffix, cfg.TypeSuffix},
+	}
+
+	for _, v := range values {
+		if *v.flag == "" {
+			*v.flag = v.value
+		}
+	}
+
+	opts.Symbols = opts.Symbols || cfg.Symbols
+	opts.Nullable = opts.Nullable || cfg.Nullable
+	opts.Initialisms = opts.Initialisms || cfg.Initialisms
+	switchOff(opts)
+
+	if len(opts.Include) == 0 {
+		opts.Include = cfg.Include
+	}
+	if len(opts.Exclude) == 0 {
+		opts.Exclude = cfg.Exclude
+	}
+	if len(opts.Tags) == 0 {
+		opts.Tags = cfg.Tags
+	}
+
+	// Mappings and table overrides that were set by the caller take precedence over those of the file
+	for name, mapping := range cfg.Types {
+		if opts.Types == nil {
+			opts.Types = make(map[string]TypeMapping)
+		}
+		if _, ok := opts.Types[name]; !ok {
+			opts.Types[name] = mapping
+		}
+	}
+	for name, table := range cfg.Tables {
+		if opts.Tables == nil {
+			opts.Tables = make(map[string]TableConfig)
+		}
+		if _, ok := opts.Tables[name]; !ok {
+			opts.Tables[name] = table
+		}
+	}
+}
+
+// switchOff applies the flags that turn off switches, which take precedence over both the
+// configuration file and the flags that turn them on.
+func switchOff(opts *Options) {
+	if opts.NoSymbols {
+		opts.Symbols = false
+	}
+	if opts.NoNullable {
+		opts.Nullable = false
+	}
+	if opts.NoInitialisms {
+		opts.Initialisms = false
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -19,16 +21,54 @@ var (
 	ErrInvalidOptions = errors.New("invalid options")
 )
 
+const (
+	// sharedFile holds everything that is not specific to a table when generating into a directory
+	sharedFile      = "cqlc_header.go"
+	generatedMarker = "// THIS FILE WAS AUTOGENERATED"
+)
+
+// Go file name suffixes that would restrict a file to a platform, i.e. the known GOOS and GOARCH
+// values of go/build, which also lists the values that are reserved for future use
+var platformSuffixes = map[string]bool{
+	"aix": true, "android": true, "darwin": true, "dragonfly": true, "freebsd": true, "hurd": true,
+	"illumos": true, "ios": true, "js": true, "linux": true, "nacl": true, "netbsd": true, "openbsd": true,
+	"plan9": true, "solaris": true, "wasip1": true, "windows": true, "zos": true,
+	"386": true, "amd64": true, "amd64p32": true, "arm": true, "armbe": true, "arm64": true, "arm64be": true,
+	"loong64": true, "mips": true, "mipsle": true, "mips64": true, "mips64le": true, "mips64p32": true,
+	"mips64p32le": true, "ppc": true, "ppc64": true, "ppc64le": true, "riscv": true, "riscv64": true,
+	"s390": true, "s390x": true, "sparc": true, "sparc64": true, "wasm": true,
+}
+
 type Options struct {
-	Instance string `short:"i" long:"instance" description:"The Cassandra instance to connect to"`
-	Keyspace string `short:"k" long:"keyspace" description:"The keyspace that contains the target schema"`
-	Package  string `short:"p" long:"package" description:"The name of the target package for the generated code"`
-	Output   string `short:"o" long:"output" description:"The file to write the generated bindings to"`
-	Version  func() `short:"V" long:"version" description:"Print cqlc version and exit"`
-	Verbose  []bool `short:"v" long:"verbose" description:"Show verbose debug information"`
-	Symbols  bool   `short:"s" long:"symbols" description:"Generate compile symbols for each column family"`
-	Username string `short:"u" long:"username" description:"Username for authentication"`
-	Password string `short:"w" long:"password" description:"Password for authentication"`
+	Instance      string   `short:"i" long:"instance" description:"The Cassandra instance to connect to"`
+	Keyspace      string   `short:"k" long:"keyspace" description:"The keyspace that contains the target schema, or a comma separated list of keyspaces and glob patterns"`
+	Package       string   `short:"p" long:"package" description:"The name of the target package for the generated code"`
+	Output        string   `short:"o" long:"output" description:"The file to write the generated bindings to"`
+	Dir           string   `short:"d" long:"dir" description:"The directory to write one file per table to, as an alternative to a single output file"`
+	Version       func()   `short:"V" long:"version" description:"Print cqlc version and exit"`
+	Verbose       []bool   `short:"v" long:"verbose" description:"Show verbose debug information"`
+	Symbols       bool     `short:"s" long:"symbols" description:"Generate compile symbols for each column family"`
+	NoSymbols     bool     `long:"no-symbols" description:"Do not generate compile symbols, even if the configuration file enables them"`
+	Username      string   `short:"u" long:"username" description:"Username for authentication"`
+	Password      string   `short:"w" long:"password" description:"Password for authentication"`
+	Include       []string `long:"include" description:"Only generate tables that match this glob pattern (may be repeated)"`
+	Exclude       []string `long:"exclude" description:"Do not generate tables that match this glob pattern (may be repeated)"`
+	Config        string   `short:"c" long:"config" description:"The configuration file to read, which defaults to cqlc.yaml if it exists"`
+	Nullable      bool     `long:"nullable" description:"Generate pointer fields for regular columns, so that null cells can be told apart from zero values"`
+	NoNullable    bool     `long:"no-nullable" description:"Do not generate pointer fields, even if the configuration file enables them"`
+	Tags          []string `long:"tag" description:"Add a struct tag with this key, e.g. json, cql, db or yaml, to the fields of each row struct (may be repeated)"`
+	TagCase       string   `long:"tag-case" description:"The naming convention of struct tag values: snake (the column name, default), camel or pascal"`
+	Initialisms   bool     `long:"initialisms" description:"Upper case common initialisms such as ID and UUID in type and field names"`
+	NoInitialisms bool     `long:"no-initialisms" description:"Do not upper case initialisms, even if the configuration file enables them"`
+	TypePrefix    string   `long:"type-prefix" description:"Prepend this to the name of each generated row struct"`
+	TypeSuffix    string   `long:"type-suffix" description:"Append this to the name of each generated row struct"`
+	Schema        string   `long:"schema" description:"The CQL file to check generated code against instead of a live keyspace (check only)"`
+	// Naming replaces the default naming strategy when the generator is used as a library
+	Naming Naming `no-flag:"true"`
+	// Types maps CQL types to custom Go types for every table, as read from the configuration file
+	Types map[string]TypeMapping `no-flag:"true"`
+	// Tables holds the per table overrides of the configuration file
+	Tables map[string]TableConfig `no-flag:"true"`
 }
 
 type Provenance struct {
@@ -39,44 +79,371 @@ type Provenance struct {
 	ServerCQL     string
 	ServerRelease string
 	HostId        gocql.UUID
+	// Fingerprint identifies the schema of the generated tables, see Check
+	Fingerprint string
 }
 
+type server struct {
+	cluster    *gocql.ClusterConfig
+	release    string
+	cqlVersion string
+	hostId     gocql.UUID
+}
+
+// Generate writes the bindings for the keyspace in the options to the output file,
+// or to one file per table in the output directory.
+// If several keyspaces are requested, each keyspace is generated into a sub-package
+// named after the keyspace, alongside the output file or within the output directory.
 func Generate(opts *Options, version string) error {
 
+	if err := LoadConfig(opts); err != nil {
+		return err
+	}
+
 	err := validateOptions(opts)
 	if err != nil {
 		return err
 	}
 
+	s, srv, err := connect(opts)
+	if err != nil {
+		return err
+	}
+
+	defer s.Close()
+
+	if !isMultiKeyspace(opts.Keyspace) {
+		if err := checkKeyspaceIncludes(s, []string{opts.Keyspace}, opts.Include); err != nil {
+			return err
+		}
+		return generateKeyspace(s, srv, opts, opts.Keyspace, opts.Package, opts.Output, opts.Dir, version)
+	}
+
+	available, err := keyspaceNames(s, srv.release)
+	if err != nil {
+		return err
+	}
+
+	keyspaces, err := expandKeyspaces(opts.Keyspace, available)
+	if err != nil {
+		return err
+	}
+
+	if err := checkKeyspaceIncludes(s, keyspaces, opts.Include); err != nil {
+		return err
+	}
+
+	for _, keyspace := range keyspaces {
+		pkg := strings.ToLower(keyspace)
+
+		var output, dir string
+		if opts.Dir != "" {
+			dir = filepath.Join(opts.Dir, pkg)
+		} else {
+			output = filepath.Join(filepath.Dir(opts.Output), pkg, filepath.Base(opts.Output))
+		}
+
+		if err := generateKeyspace(s, srv, opts, keyspace, pkg, output, dir, version); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func generateKeyspace(s *gocql.Session, srv server, opts *Options, keyspace, pkg, output, dir, version string) error {
+	meta, err := keyspaceMeta(s, srv, opts, keyspace, pkg, version)
+	if err != nil {
+		return err
+	}
+
+	if dir != "" {
+		return writeDir(dir, meta)
+	}
+
 	var b bytes.Buffer
-	if err = generateBinding(opts, version, &b); err != nil {
+	if err := generateBinding(meta, &b); err != nil {
 		return err
 	}
 	if b.Len() > 0 {
-		if err := os.MkdirAll(filepath.Dir(opts.Output), 0755); err != nil {
+		if err := os.MkdirAll(filepath.Dir(output), 0755); err != nil {
 			return err
 		}
-		if err := ioutil.WriteFile(opts.Output, b.Bytes(), 0644); err != nil {
+		if err := ioutil.WriteFile(output, b.Bytes(), 0644); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// writeDir writes the shared file and one file per table to a directory, and removes any
+// files that a previous run generated for tables that no longer exist.
+func writeDir(dir string, meta map[string]interface{}) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	files := make(map[string][]byte)
+
+	shared, err := renderTemplate("shared", meta)
+	if err != nil {
+		return err
+	}
+	files[sharedFile] = shared
+
+	for _, table := range meta["Tables"].([]map[string]interface{}) {
+		src, err := renderTemplate("tableFile", table)
+		if err != nil {
+			return err
+		}
+		name := table["Table"].(*gocql.TableMetadata).Name
+		file := tableFileName(name)
+		if _, ok := files[file]; ok {
+			return fmt.Errorf("Cannot generate table %s into %s, which is already generated", name, file)
+		}
+		files[file] = src
+	}
+
+	existing, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	for _, path := range existing {
+		generated, err := isGenerated(path)
+		if err != nil {
+			return err
+		}
+		_, regenerated := files[filepath.Base(path)]
+		if regenerated && !generated {
+			return fmt.Errorf("Refusing to overwrite %s, which was not generated by cqlc", path)
+		}
+		if !regenerated && generated {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+
+	for name, src := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), src, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isGenerated(path string) (bool, error) {
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+	return bytes.HasPrefix(src, []byte(generatedMarker)), nil
+}
+
+// tableFileName avoids file names that the go tool would treat as tests or restrict to a platform.
+func tableFileName(table string) string {
+	parts := strings.Split(table, "_")
+	last := parts[len(parts)-1]
+	if len(parts) > 1 && (last == "test" || platformSuffixes[last]) {
+		return table + "_table.go"
+	}
+	return table + ".go"
+}
+
 func validateOptions(opts *Options) error {
-	if opts.Instance == "" || opts.Keyspace == "" || opts.Package == "" || opts.Output == "" {
+	if opts.Instance == "" || opts.Keyspace == "" {
+		return ErrInvalidOptions
+	}
+	// Exactly one of the output file and the output directory needs to be given
+	if (opts.Output == "") == (opts.Dir == "") {
+		return ErrInvalidOptions
+	}
+	// Each keyspace gets its own package when generating several keyspaces
+	if opts.Package == "" && !isMultiKeyspace(opts.Keyspace) {
 		return ErrInvalidOptions
 	}
 	if (opts.Username == "" && opts.Password != "") || (opts.Username != "" && opts.Password == "") {
 		return ErrInvalidOptions
 	}
+	if _, ok := tagCases[opts.TagCase]; !ok {
+		return ErrInvalidOptions
+	}
+	return nil
+}
+
+// isMultiKeyspace returns true if the keyspace option lists several keyspaces or contains a glob pattern.
+func isMultiKeyspace(spec string) bool {
+	return strings.ContainsAny(spec, ",*?[")
+}
+
+// expandKeyspaces resolves a comma separated list of keyspaces and glob patterns against the
+// keyspaces that exist on the server. Patterns do not match the system keyspaces, which can
+// still be named explicitly.
+func expandKeyspaces(spec string, available []string) ([]string, error) {
+	sorted := make([]string, len(available))
+	copy(sorted, available)
+	sort.Strings(sorted)
+
+	seen := make(map[string]bool)
+	keyspaces := make([]string, 0)
+
+	add := func(keyspace string) {
+		if !seen[keyspace] {
+			seen[keyspace] = true
+			keyspaces = append(keyspaces, keyspace)
+		}
+	}
+
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		if !strings.ContainsAny(part, "*?[") {
+			add(part)
+			continue
+		}
+
+		matched := false
+		for _, keyspace := range sorted {
+			if strings.HasPrefix(keyspace, "system") {
+				continue
+			}
+			ok, err := path.Match(part, keyspace)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid keyspace pattern %s: %v", part, err)
+			}
+			if ok {
+				matched = true
+				add(keyspace)
+			}
+		}
+
+		if !matched {
+			return nil, fmt.Errorf("No keyspaces match %s", part)
+		}
+	}
+
+	if len(keyspaces) == 0 {
+		return nil, ErrInvalidOptions
+	}
+
+	return keyspaces, nil
+}
+
+// filterTables returns the tables whose names match at least one of the include patterns,
+// or all tables if there are none, and none of the exclude patterns.
+func filterTables(tables map[string]*gocql.TableMetadata, include, exclude []string) (map[string]*gocql.TableMetadata, error) {
+	filtered := make(map[string]*gocql.TableMetadata)
+
+	for name, table := range tables {
+		included := len(include) == 0
+		for _, pattern := range include {
+			ok, err := path.Match(pattern, name)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid include pattern %s: %v", pattern, err)
+			}
+			if ok {
+				included = true
+			}
+		}
+
+		for _, pattern := range exclude {
+			ok, err := path.Match(pattern, name)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid exclude pattern %s: %v", pattern, err)
+			}
+			if ok {
+				included = false
+			}
+		}
+
+		if included {
+			filtered[name] = table
+		}
+	}
+
+	return filtered, nil
+}
+
+// checkIncludes returns an error if an include pattern does not match any of the tables
+// of any of the keyspaces, which is most likely a typo.
+func checkIncludes(include []string, keyspaces ...map[string]*gocql.TableMetadata) error {
+	for _, pattern := range include {
+		matched := false
+		for _, tables := range keyspaces {
+			for name := range tables {
+				if ok, _ := path.Match(pattern, name); ok {
+					matched = true
+				}
+			}
+		}
+		if !matched {
+			return fmt.Errorf("No tables match %s", pattern)
+		}
+	}
 	return nil
 }
 
-func coalesceImports(md *gocql.KeyspaceMetadata) []string {
+// checkKeyspaceIncludes checks the include patterns against the tables of all of the keyspaces
+// that are generated, so that a pattern only needs to match the tables of one of them.
+func checkKeyspaceIncludes(s *gocql.Session, keyspaces, include []string) error {
+	if len(include) == 0 {
+		return nil
+	}
+
+	tables := make([]map[string]*gocql.TableMetadata, 0, len(keyspaces))
+	for _, keyspace := range keyspaces {
+		md, err := s.KeyspaceMetadata(keyspace)
+		if err != nil {
+			return err
+		}
+		tables = append(tables, md.Tables)
+	}
+
+	return checkIncludes(include, tables...)
+}
+
+func keyspaceNames(s *gocql.Session, release string) ([]string, error) {
+	major, err := releaseMajor(release)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := `SELECT keyspace_name FROM system.schema_keyspaces`
+	if major >= 3 {
+		stmt = `SELECT keyspace_name FROM system_schema.keyspaces`
+	}
+
+	names := make([]string, 0)
+	var name string
+	iter := s.Query(stmt).Iter()
+	for iter.Scan(&name) {
+		names = append(names, name)
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
+func releaseMajor(release string) (int, error) {
+	major, err := strconv.Atoi(strings.Split(release, ".")[0])
+	if err != nil {
+		return 0, fmt.Errorf("Could not parse server release %s: %v", release, err)
+	}
+	return major, nil
+}
+
+func coalesceImports(opts *Options, md *gocql.KeyspaceMetadata) []string {
 
 	set := make(map[string]bool)
-	for _, path := range importPaths(md) {
+	for _, path := range importPaths(opts, md) {
 		set[path] = true
 	}
 
@@ -92,7 +459,7 @@ func coalesceImports(md *gocql.KeyspaceMetadata) []string {
 	return paths
 }
 
-func generateBinding(opts *Options, version string, w io.Writer) error {
+func connect(opts *Options) (*gocql.Session, server, error) {
 
 	cluster := gocql.NewCluster(opts.Instance)
 
@@ -106,22 +473,22 @@ func generateBinding(opts *Options, version string, w io.Writer) error {
 	s, err := cluster.CreateSession()
 
 	if err != nil {
-		return fmt.Errorf("Connect error", err)
+		return nil, server{}, fmt.Errorf("Connect error", err)
 	}
 
-	defer s.Close()
-
 	var protoString, release, cqlVersion string
 	var hostId gocql.UUID
 	err = s.Query(`SELECT native_protocol_version, release_version, cql_version, host_id
 		           FROM system.local`).Scan(&protoString, &release, &cqlVersion, &hostId)
 	if err != nil {
-		return fmt.Errorf("System metadata error", err)
+		s.Close()
+		return nil, server{}, fmt.Errorf("System metadata error", err)
 	}
 
 	proto, err := strconv.Atoi(protoString)
 	if err != nil {
-		return fmt.Errorf("Could not parse protocol version", err)
+		s.Close()
+		return nil, server{}, fmt.Errorf("Could not parse protocol version", err)
 	}
 
 	if proto > 3 {
@@ -130,38 +497,310 @@ func generateBinding(opts *Options, version string, w io.Writer) error {
 		s, err = cluster.CreateSession()
 
 		if err != nil {
-			return fmt.Errorf("Re-connect error", err)
+			return nil, server{}, fmt.Errorf("Re-connect error", err)
 		}
 	}
 
-	md, err := s.KeyspaceMetadata(opts.Keyspace)
+	srv := server{
+		cluster:    cluster,
+		release:    release,
+		cqlVersion: cqlVersion,
+		hostId:     hostId,
+	}
+
+	return s, srv, nil
+}
+
+func keyspaceMeta(s *gocql.Session, srv server, opts *Options, keyspace, pkg, version string) (map[string]interface{}, error) {
+
+	md, err := s.KeyspaceMetadata(keyspace)
 
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	// Imports are derived from the tables, so the filtering is applied to a copy of the whole keyspace
+	tables, err := filterTables(md.Tables, opts.Include, opts.Exclude)
+
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := *md
+	filtered.Tables = tables
+	md = &filtered
+
+	if err := checkTypeMappings(opts, md); err != nil {
+		return nil, err
+	}
+
+	views, err := viewNames(s, keyspace, srv.release)
+
+	if err != nil {
+		return nil, err
 	}
 
 	provenance := Provenance{
-		Keyspace:      opts.Keyspace,
+		Keyspace:      keyspace,
 		Version:       version,
 		Timestamp:     time.Now(),
-		HostId:        hostId,
-		NegotiatedCQL: cluster.CQLVersion,
-		ServerCQL:     cqlVersion,
-		ServerRelease: release,
+		HostId:        srv.hostId,
+		NegotiatedCQL: srv.cluster.CQLVersion,
+		ServerCQL:     srv.cqlVersion,
+		ServerRelease: srv.release,
+	}
+
+	// The package may differ between keyspaces, so the template is handed a copy of the options
+	keyspaceOpts := *opts
+	keyspaceOpts.Keyspace = keyspace
+	keyspaceOpts.Package = pkg
+
+	return bindingMeta(provenance, &keyspaceOpts, md, views)
+}
+
+// bindingMeta assembles the data that the binding template renders a keyspace from.
+// Each table is rendered from its own context, which carries the imports that the table needs
+// on its own for when the tables are written to separate files.
+func bindingMeta(provenance Provenance, opts *Options, md *gocql.KeyspaceMetadata, views map[string]bool) (map[string]interface{}, error) {
+	names := make([]string, 0, len(md.Tables))
+	for name := range md.Tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	schema := keyspaceSchema(md)
+	provenance.Fingerprint = fingerprint(provenance.Keyspace, schema)
+
+	tables := make([]map[string]interface{}, 0, len(names))
+	for _, name := range names {
+		single := *md
+		single.Tables = map[string]*gocql.TableMetadata{name: md.Tables[name]}
+
+		table := make(map[string]interface{})
+		table["Provenance"] = provenance
+		table["Options"] = opts
+		table["Imports"] = coalesceImports(opts, &single)
+		table["Views"] = views
+		table["Table"] = md.Tables[name]
+		table["Name"] = name
+		table["Type"], table["Fields"], table["Symbols"] = tableNames(opts, md.Tables[name])
+		table["Symbol"], table["ColumnSymbols"] = tableSymbols(opts, md.Tables[name])
+		table["Tags"] = tableTags(opts, md.Tables[name])
+		table["Values"], table["Columns"], table["Nullable"] = tableTypes(opts, md.Tables[name], table["Type"].(string), table["Fields"].(map[string]string))
+		table["Mapped"] = mappedColumns(opts, md.Tables[name])
+		tables = append(tables, table)
+	}
+
+	if err := checkIdentifiers(tables); err != nil {
+		return nil, err
 	}
 
 	meta := make(map[string]interface{})
 	meta["Provenance"] = provenance
 	meta["Options"] = opts
-	meta["Imports"] = coalesceImports(md)
-	meta["Tables"] = md.Tables
+	meta["Imports"] = coalesceImports(opts, md)
+	meta["Tables"] = tables
+	meta["Views"] = views
+	meta["Schema"] = schema
 
-	var b bytes.Buffer
-	if err := bindingTemplate.Execute(&b, meta); err != nil {
-		return err
+	return meta, nil
+}
+
+// tableNames resolves the struct type name and the struct field names of a table,
+// as well as whether it gets a compile symbol, applying any overrides from the configuration file.
+func tableNames(opts *Options, table *gocql.TableMetadata) (string, map[string]string, bool) {
+	cfg := opts.Tables[table.Name]
+	naming := namingStrategy(opts)
+
+	structType := naming.TypeName(table.Name)
+	if cfg.Type != "" {
+		structType = cfg.Type
+	}
+	structType = escapeIdentifier(structType)
+
+	fields := make(map[string]string)
+	for name := range table.Columns {
+		fields[name] = naming.FieldName(name)
+		if field, ok := cfg.Fields[name]; ok {
+			fields[name] = field
+		}
+		fields[name] = escapeIdentifier(fields[name])
+	}
+
+	symbols := opts.Symbols
+	if cfg.Symbols != nil {
+		symbols = *cfg.Symbols
+	}
+
+	return structType, fields, symbols
+}
+
+// tableSymbols resolves the name of the compile symbol of a table and the names of the fields
+// of its table definition.
+func tableSymbols(opts *Options, table *gocql.TableMetadata) (string, map[string]string) {
+	naming := namingStrategy(opts)
+
+	columns := make(map[string]string)
+	for name := range table.Columns {
+		columns[name] = escapeIdentifier(naming.Symbol(name))
+	}
+
+	return escapeIdentifier(naming.Symbol(table.Name)), columns
+}
+
+// tagCases converts column names to struct tag values by naming convention.
+var tagCases = map[string]func(string) string{
+	"":       func(name string) string { return name },
+	"snake":  func(name string) string { return name },
+	"pascal": snakeToCamel,
+	"camel": func(name string) string {
+		camel := snakeToCamel(name)
+		if camel == "" {
+			return camel
+		}
+		return strings.ToLower(camel[:1]) + camel[1:]
+	},
+}
+
+// tableTags renders the struct tag of the row struct field of each column of a table.
+func tableTags(opts *Options, table *gocql.TableMetadata) map[string]string {
+	tags := make(map[string]string)
+	if len(opts.Tags) == 0 {
+		return tags
+	}
+
+	convert := tagCases[opts.TagCase]
+	for name := range table.Columns {
+		pairs := make([]string, len(opts.Tags))
+		for i, key := range opts.Tags {
+			pairs[i] = fmt.Sprintf("%s:%q", key, convert(name))
+		}
+		tags[name] = "`" + strings.Join(pairs, " ") + "`"
+	}
+
+	return tags
+}
+
+// tableTypes resolves the Go value type and the column definition type of each column of a table,
+// as well as which columns are nullable.
+// A column that is mapped to a custom Go type is defined by its generated column type, because the
+// column types of the cqlc package are bound to the built in value types.
+func tableTypes(opts *Options, table *gocql.TableMetadata, structType string, fields map[string]string) (map[string]string, map[string]string, map[string]bool) {
+	values := make(map[string]string)
+	columns := make(map[string]string)
+	nullable := make(map[string]bool)
+
+	for name, col := range table.Columns {
+		if mapping, ok := typeMapping(opts, table.Name, col); ok {
+			values[name] = mapping.Type
+			columns[name] = fmt.Sprintf("*%s%sColumn", structType, fields[name])
+		} else {
+			values[name] = valueType(*col)
+			columns[name] = columnType(*col, table)
+		}
+		nullable[name] = opts.Nullable && isNullable(*col, values[name])
+	}
+
+	return values, columns, nullable
+}
+
+// isNullable returns true for the columns that need a pointer field to represent a null cell.
+// Key columns can never be null, whereas collections, blobs, decimals and varints
+// already have a nil value and counters are always read as a number.
+func isNullable(c gocql.ColumnMetadata, value string) bool {
+	if c.Kind == gocql.PARTITION_KEY || c.Kind == gocql.CLUSTERING_KEY {
+		return false
+	}
+	if isCounterColumn(c) || isListType(c) || c.Type.Type() == gocql.TypeMap {
+		return false
+	}
+	return !strings.HasPrefix(value, "*") && !strings.HasPrefix(value, "[]")
+}
+
+// mappedColumns returns the columns of a table that are mapped to a custom Go type.
+func mappedColumns(opts *Options, table *gocql.TableMetadata) map[string]bool {
+	mapped := make(map[string]bool)
+	for name, col := range table.Columns {
+		_, mapped[name] = typeMapping(opts, table.Name, col)
+	}
+	return mapped
+}
+
+// typeMapping returns the custom Go type of a column, if there is one.
+// A mapping of the column itself takes precedence over a mapping of its CQL type.
+func typeMapping(opts *Options, table string, col *gocql.ColumnMetadata) (TypeMapping, bool) {
+	if mapping, ok := opts.Tables[table].Types[col.Name]; ok {
+		return mapping, true
+	}
+
+	if isCounterColumn(*col) {
+		return TypeMapping{}, false
+	}
+
+	for name, mapping := range opts.Types {
+		if cqlTypes[name] == col.Type.Type() {
+			return mapping, true
+		}
+	}
+
+	return TypeMapping{}, false
+}
+
+// checkTypeMappings rejects mappings that cannot be generated for the tables of a keyspace.
+func checkTypeMappings(opts *Options, md *gocql.KeyspaceMetadata) error {
+	check := func(name string, mapping TypeMapping) error {
+		if mapping.Type == "" {
+			return fmt.Errorf("No Go type given for the type mapping of %s", name)
+		}
+		if strings.Contains(mapping.Type, ".") && mapping.Import == "" {
+			return fmt.Errorf("No import path given for the type %s of %s", mapping.Type, name)
+		}
+		return nil
+	}
+
+	names := make([]string, 0, len(opts.Types))
+	for name := range opts.Types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Aliases such as text and varchar resolve to the same type, so only one of them can be mapped
+	mapped := make(map[gocql.Type]string)
+	for _, name := range names {
+		t, ok := cqlTypes[name]
+		if !ok {
+			return fmt.Errorf("Cannot map the CQL type %s to a Go type", name)
+		}
+		if alias, ok := mapped[t]; ok {
+			return fmt.Errorf("Cannot map both %s and %s, which are the same CQL type", alias, name)
+		}
+		mapped[t] = name
+		if err := check(name, opts.Types[name]); err != nil {
+			return err
+		}
 	}
 
-	bfmt, err := format.Source(b.Bytes())
+	for _, table := range md.Tables {
+		for name, mapping := range opts.Tables[table.Name].Types {
+			col, ok := table.Columns[name]
+			if !ok {
+				return fmt.Errorf("Cannot map the unknown column %s.%s to a Go type", table.Name, name)
+			}
+			if isCounterColumn(*col) {
+				return fmt.Errorf("Cannot map the counter column %s.%s to a Go type", table.Name, name)
+			}
+			if err := check(table.Name+"."+name, mapping); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func generateBinding(meta map[string]interface{}, w io.Writer) error {
+
+	bfmt, err := renderTemplate("binding.tmpl", meta)
 	if err != nil {
 		return err
 	}
@@ -173,9 +812,46 @@ func generateBinding(opts *Options, version string, w io.Writer) error {
 	return nil
 }
 
-func importPaths(md *gocql.KeyspaceMetadata) (imports []string) {
+func renderTemplate(name string, data interface{}) ([]byte, error) {
+	var b bytes.Buffer
+	if err := bindingTemplate.ExecuteTemplate(&b, name, data); err != nil {
+		return nil, err
+	}
+
+	return format.Source(b.Bytes())
+}
+
+// viewNames returns the names of the materialized views in a keyspace, which are only supported from Cassandra 3.0.
+// Drivers that expose views do so as part of the keyspace's tables, so this is used to render them as read-only.
+func viewNames(s *gocql.Session, keyspace, release string) (map[string]bool, error) {
+	views := make(map[string]bool)
+
+	major, err := releaseMajor(release)
+	if err != nil {
+		return nil, err
+	}
+
+	if major < 3 {
+		return views, nil
+	}
+
+	var name string
+	iter := s.Query(`SELECT view_name FROM system_schema.views WHERE keyspace_name = ?`, keyspace).Iter()
+	for iter.Scan(&name) {
+		views[name] = true
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	return views, nil
+}
+
+func importPaths(opts *Options, md *gocql.KeyspaceMetadata) (imports []string) {
 	// Ideally need to use a set
 	paths := make(map[string]bool)
+	mapped := make(map[string]bool)
 
 	f := func(t gocql.TypeInfo) {
 		literal := literalTypes[t.Type()]
@@ -186,6 +862,18 @@ func importPaths(md *gocql.KeyspaceMetadata) (imports []string) {
 
 	for _, table := range md.Tables {
 		for _, col := range table.Columns {
+			if isTimeUUID(*col) && supportsClustering(*col) {
+				// Clustered timeuuid columns support time based range conditions
+				paths["time.Time"] = true
+			}
+
+			if mapping, ok := typeMapping(opts, table.Name, col); ok {
+				if mapping.Import != "" {
+					mapped[mapping.Import] = true
+				}
+				continue
+			}
+
 			t := col.Type
 			switch t.Type() {
 			case gocql.TypeList, gocql.TypeSet:
@@ -214,5 +902,9 @@ func importPaths(md *gocql.KeyspaceMetadata) (imports []string) {
 		}
 	}
 
+	for path, _ := range mapped {
+		imports = append(imports, path)
+	}
+
 	return imports
 }
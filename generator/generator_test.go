@@ -1,7 +1,12 @@
 package generator
 
 import (
+	"bytes"
+	"github.com/gocql/gocql"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -119,6 +124,14 @@ func TestDistinctGenerator(t *testing.T) {
 	assert.Equal(t, out, "PASSED")
 }
 
+func TestViewGenerator(t *testing.T) {
+
+	out, err := runFixture("view", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
 func TestSecondaryGenerator(t *testing.T) {
 
 	out, err := runFixture("secondary", opts)
@@ -190,3 +203,625 @@ func TestCompositeIndex(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, out, "PASSED")
 }
+
+func TestScan(t *testing.T) {
+
+	out, err := runFixture("scan", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestToken(t *testing.T) {
+
+	out, err := runFixture("token", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestTuple(t *testing.T) {
+
+	out, err := runFixture("tuple", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestFiltering(t *testing.T) {
+
+	out, err := runFixture("filtering", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestSelector(t *testing.T) {
+
+	out, err := runFixture("selector", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestTimeUUID(t *testing.T) {
+
+	out, err := runFixture("timeuuid", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestServerFunctions(t *testing.T) {
+
+	out, err := runFixture("server_functions", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestCounterDelta(t *testing.T) {
+
+	out, err := runFixture("counter_delta", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestBatchBuilder(t *testing.T) {
+
+	out, err := runFixture("batch_builder", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestBulkWriter(t *testing.T) {
+
+	out, err := runFixture("bulk", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestBatchConditional(t *testing.T) {
+
+	out, err := runFixture("batch_conditional", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestStatic(t *testing.T) {
+
+	out, err := runFixture("static", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestCustomTypes(t *testing.T) {
+
+	custom := *opts
+	custom.Types = map[string]TypeMapping{"decimal": TypeMapping{Type: "Money"}}
+	custom.Tables = map[string]TableConfig{
+		"payments": TableConfig{
+			Types: map[string]TypeMapping{
+				"id":     TypeMapping{Type: "PaymentID"},
+				"status": TypeMapping{Type: "PaymentStatus"},
+			},
+		},
+	}
+
+	out, err := runFixture("custom_types", &custom)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestNullable(t *testing.T) {
+
+	nullable := *opts
+	nullable.Nullable = true
+
+	out, err := runFixture("nullable", &nullable)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestTags(t *testing.T) {
+
+	tagged := *opts
+	tagged.Tags = []string{"json", "db"}
+	tagged.TagCase = "camel"
+
+	out, err := runFixture("tags", &tagged)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestNaming(t *testing.T) {
+
+	named := *opts
+	named.Initialisms = true
+	named.TypeSuffix = "Row"
+
+	out, err := runFixture("naming", &named)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestCheckKeyspace(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "cqlc")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	generated := *opts
+	generated.Output = filepath.Join(dir, "binding.go")
+	assert.NoError(t, Generate(&generated, "test_version"))
+
+	checked := Options{Instance: opts.Instance, Output: generated.Output}
+	assert.NoError(t, Check(&checked, ioutil.Discard))
+
+	// A narrower set of tables than was generated is reported as drift
+	checked.Include = []string{"basic"}
+	assert.Equal(t, Check(&checked, ioutil.Discard), ErrSchemaDrift)
+}
+
+func TestValidateSchema(t *testing.T) {
+
+	out, err := runFixture("validate_schema", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, out, "PASSED")
+}
+
+func TestExpandKeyspaces(t *testing.T) {
+
+	available := []string{"system", "system_auth", "orders", "orders_archive", "users"}
+
+	keyspaces, err := expandKeyspaces("users, orders*", available)
+	assert.NoError(t, err)
+	assert.Equal(t, keyspaces, []string{"users", "orders", "orders_archive"})
+
+	keyspaces, err = expandKeyspaces("*,system", available)
+	assert.NoError(t, err)
+	assert.Equal(t, keyspaces, []string{"orders", "orders_archive", "users", "system"})
+
+	_, err = expandKeyspaces("billing_*", available)
+	assert.Error(t, err)
+}
+
+func TestFilterTables(t *testing.T) {
+
+	tables := map[string]*gocql.TableMetadata{
+		"events":             &gocql.TableMetadata{Name: "events"},
+		"significant_events": &gocql.TableMetadata{Name: "significant_events"},
+		"user_accounts":      &gocql.TableMetadata{Name: "user_accounts"},
+	}
+
+	filtered, err := filterTables(tables, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, len(filtered), 3)
+
+	filtered, err = filterTables(tables, []string{"*events"}, []string{"significant_*"})
+	assert.NoError(t, err)
+	assert.Equal(t, len(filtered), 1)
+	assert.NotNil(t, filtered["events"])
+
+	filtered, err = filterTables(tables, nil, []string{"user_*"})
+	assert.NoError(t, err)
+	assert.Equal(t, len(filtered), 2)
+
+	filtered, err = filterTables(tables, []string{"orders"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, len(filtered), 0)
+
+	assert.Error(t, checkIncludes([]string{"orders"}, tables))
+
+	orders := map[string]*gocql.TableMetadata{"orders": &gocql.TableMetadata{Name: "orders"}}
+	assert.NoError(t, checkIncludes([]string{"orders*", "*events"}, tables, orders))
+	assert.Error(t, checkIncludes([]string{"orders*", "payments"}, tables, orders))
+}
+
+func TestTableFileName(t *testing.T) {
+
+	assert.Equal(t, tableFileName("events"), "events.go")
+	assert.Equal(t, tableFileName("latest"), "latest.go")
+	assert.Equal(t, tableFileName("load_test"), "load_test_table.go")
+	assert.Equal(t, tableFileName("devices_linux"), "devices_linux_table.go")
+	assert.Equal(t, tableFileName("assets_wasm"), "assets_wasm_table.go")
+	assert.Equal(t, tableFileName("hosts_illumos"), "hosts_illumos_table.go")
+	assert.Equal(t, tableFileName("cpus_riscv64"), "cpus_riscv64_table.go")
+}
+
+func TestTableFileNameCollision(t *testing.T) {
+
+	id := &gocql.ColumnMetadata{Name: "id", Kind: gocql.PARTITION_KEY, Type: gocql.NewNativeType(3, gocql.TypeVarchar, "")}
+	table := &gocql.TableMetadata{
+		Name:         "cqlc_header",
+		Columns:      map[string]*gocql.ColumnMetadata{"id": id},
+		PartitionKey: []*gocql.ColumnMetadata{id},
+	}
+	md := &gocql.KeyspaceMetadata{Name: "cqlc", Tables: map[string]*gocql.TableMetadata{"cqlc_header": table}}
+
+	meta, err := bindingMeta(Provenance{Keyspace: "cqlc"}, &Options{Package: "main"}, md, nil)
+	assert.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "cqlc")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.Error(t, writeDir(dir, meta))
+}
+
+func TestMergeConfig(t *testing.T) {
+
+	o := &Options{Keyspace: "flags", Exclude: []string{"tmp_*"}}
+	cfg := &Config{
+		Keyspace: "file",
+		Package:  "model",
+		Symbols:  true,
+		Exclude:  []string{"legacy_*"},
+		Include:  []string{"*events"},
+	}
+
+	mergeConfig(o, cfg)
+
+	assert.Equal(t, o.Keyspace, "flags")
+	assert.Equal(t, o.Package, "model")
+	assert.True(t, o.Symbols)
+	assert.Equal(t, o.Include, []string{"*events"})
+	assert.Equal(t, o.Exclude, []string{"tmp_*"})
+
+	o = &Options{NoSymbols: true, Nullable: true}
+	mergeConfig(o, &Config{Symbols: true, Initialisms: true})
+
+	assert.False(t, o.Symbols)
+	assert.True(t, o.Nullable)
+	assert.True(t, o.Initialisms)
+
+	o = &Options{NoNullable: true, NoInitialisms: true}
+	mergeConfig(o, &Config{Nullable: true, Initialisms: true})
+
+	assert.False(t, o.Nullable)
+	assert.False(t, o.Initialisms)
+
+	o = &Options{
+		Types:  map[string]TypeMapping{"uuid": TypeMapping{Type: "UUID"}},
+		Tables: map[string]TableConfig{"events": TableConfig{Type: "Event"}},
+	}
+	mergeConfig(o, &Config{
+		Types:  map[string]TypeMapping{"uuid": TypeMapping{Type: "uuid.UUID", Import: "github.com/google/uuid"}, "decimal": TypeMapping{Type: "Money"}},
+		Tables: map[string]TableConfig{"events": TableConfig{Type: "Reading"}, "payments": TableConfig{Type: "Payment"}},
+	})
+
+	assert.Equal(t, o.Types["uuid"].Type, "UUID")
+	assert.Equal(t, o.Types["decimal"].Type, "Money")
+	assert.Equal(t, o.Tables["events"].Type, "Event")
+	assert.Equal(t, o.Tables["payments"].Type, "Payment")
+}
+
+func TestTableNames(t *testing.T) {
+
+	table := &gocql.TableMetadata{
+		Name: "user_accounts",
+		Columns: map[string]*gocql.ColumnMetadata{
+			"username":     &gocql.ColumnMetadata{Name: "username"},
+			"last_visited": &gocql.ColumnMetadata{Name: "last_visited"},
+		},
+	}
+
+	structType, fields, symbols := tableNames(&Options{Symbols: true}, table)
+	assert.Equal(t, structType, "UserAccounts")
+	assert.Equal(t, fields["last_visited"], "LastVisited")
+	assert.True(t, symbols)
+
+	off := false
+	o := &Options{
+		Symbols: true,
+		Tables: map[string]TableConfig{
+			"user_accounts": TableConfig{
+				Type:    "Account",
+				Symbols: &off,
+				Fields:  map[string]string{"last_visited": "SeenAt"},
+			},
+		},
+	}
+
+	structType, fields, symbols = tableNames(o, table)
+	assert.Equal(t, structType, "Account")
+	assert.Equal(t, fields["last_visited"], "SeenAt")
+	assert.Equal(t, fields["username"], "Username")
+	assert.False(t, symbols)
+
+	// Configured names are escaped in the same way as derived ones
+	o.Tables["user_accounts"] = TableConfig{Type: "type", Fields: map[string]string{"last_visited": "2nd"}}
+
+	structType, fields, _ = tableNames(o, table)
+	assert.Equal(t, structType, "type_")
+	assert.Equal(t, fields["last_visited"], "X2nd")
+}
+
+func TestTypeMappings(t *testing.T) {
+
+	table := &gocql.TableMetadata{
+		Name: "payments",
+		Columns: map[string]*gocql.ColumnMetadata{
+			"id":     &gocql.ColumnMetadata{Name: "id", Type: gocql.NewNativeType(3, gocql.TypeUUID, "")},
+			"status": &gocql.ColumnMetadata{Name: "status", Kind: gocql.REGULAR, Type: gocql.NewNativeType(3, gocql.TypeVarchar, "")},
+			"amount": &gocql.ColumnMetadata{Name: "amount", Type: gocql.NewNativeType(3, gocql.TypeDecimal, "")},
+		},
+	}
+	md := &gocql.KeyspaceMetadata{Tables: map[string]*gocql.TableMetadata{"payments": table}}
+
+	o := &Options{
+		Types: map[string]TypeMapping{"uuid": TypeMapping{Type: "uuid.UUID", Import: "github.com/google/uuid"}},
+		Tables: map[string]TableConfig{
+			"payments": TableConfig{
+				Types: map[string]TypeMapping{"amount": TypeMapping{Type: "*Money"}},
+			},
+		},
+	}
+
+	fields := map[string]string{"id": "Id", "status": "Status", "amount": "Amount"}
+	values, columns, _ := tableTypes(o, table, "Payments", fields)
+
+	assert.NoError(t, checkTypeMappings(o, md))
+	assert.Equal(t, values["id"], "uuid.UUID")
+	assert.Equal(t, values["amount"], "*Money")
+	assert.Equal(t, values["status"], "string")
+	assert.Equal(t, columns["id"], "*PaymentsIdColumn")
+	assert.Equal(t, columns["status"], "cqlc.FilterableStringColumn")
+	assert.True(t, mappedColumns(o, table)["id"])
+	assert.False(t, mappedColumns(o, table)["status"])
+
+	// The default import of decimal columns is replaced by that of the mapped uuid type
+	assert.Equal(t, importPaths(o, md), []string{"github.com/google/uuid"})
+
+	o.Types["text"] = TypeMapping{Type: "Status"}
+	_, columns, _ = tableTypes(o, table, "Payments", fields)
+	assert.Equal(t, columns["status"], "*PaymentsStatusColumn")
+
+	o.Types["varchar"] = TypeMapping{Type: "Status"}
+	assert.Error(t, checkTypeMappings(o, md))
+	delete(o.Types, "varchar")
+
+	o.Types["uuid"] = TypeMapping{Type: "uuid.UUID"}
+	assert.Error(t, checkTypeMappings(o, md))
+
+	delete(o.Types, "uuid")
+	o.Types["list"] = TypeMapping{Type: "Tags"}
+	assert.Error(t, checkTypeMappings(o, md))
+
+	delete(o.Types, "list")
+	o.Tables["payments"].Types["refunded"] = TypeMapping{Type: "bool"}
+	assert.Error(t, checkTypeMappings(o, md))
+}
+
+func TestNullableColumns(t *testing.T) {
+
+	native := func(name string, kind gocql.ColumnKind, typ gocql.Type) *gocql.ColumnMetadata {
+		return &gocql.ColumnMetadata{Name: name, Kind: kind, Type: gocql.NewNativeType(3, typ, "")}
+	}
+
+	table := &gocql.TableMetadata{
+		Name: "readings",
+		Columns: map[string]*gocql.ColumnMetadata{
+			"sensor":   native("sensor", gocql.PARTITION_KEY, gocql.TypeBigInt),
+			"location": native("location", gocql.STATIC, gocql.TypeVarchar),
+			"value":    native("value", gocql.REGULAR, gocql.TypeDouble),
+			"payload":  native("payload", gocql.REGULAR, gocql.TypeBlob),
+			"price":    native("price", gocql.REGULAR, gocql.TypeDecimal),
+		},
+	}
+	table.PartitionKey = []*gocql.ColumnMetadata{table.Columns["sensor"]}
+
+	fields := map[string]string{"sensor": "Sensor", "location": "Location", "value": "Value", "payload": "Payload", "price": "Price"}
+
+	_, _, nullable := tableTypes(&Options{}, table, "Readings", fields)
+	assert.False(t, nullable["value"])
+
+	_, columns, nullable := tableTypes(&Options{Nullable: true}, table, "Readings", fields)
+	assert.False(t, nullable["sensor"])
+	assert.True(t, nullable["location"])
+	assert.True(t, nullable["value"])
+	assert.False(t, nullable["payload"])
+	assert.False(t, nullable["price"])
+	assert.Equal(t, columns["value"], "cqlc.FilterableFloat64Column")
+}
+
+func TestTableTags(t *testing.T) {
+
+	table := &gocql.TableMetadata{
+		Name: "user_accounts",
+		Columns: map[string]*gocql.ColumnMetadata{
+			"last_visited": &gocql.ColumnMetadata{Name: "last_visited"},
+		},
+	}
+
+	assert.Equal(t, tableTags(&Options{}, table)["last_visited"], "")
+
+	tags := tableTags(&Options{Tags: []string{"json", "cql"}}, table)
+	assert.Equal(t, tags["last_visited"], "`json:\"last_visited\" cql:\"last_visited\"`")
+
+	tags = tableTags(&Options{Tags: []string{"yaml"}, TagCase: "camel"}, table)
+	assert.Equal(t, tags["last_visited"], "`yaml:\"lastVisited\"`")
+
+	tags = tableTags(&Options{Tags: []string{"db"}, TagCase: "pascal"}, table)
+	assert.Equal(t, tags["last_visited"], "`db:\"LastVisited\"`")
+}
+
+func TestDefaultNaming(t *testing.T) {
+
+	plain := DefaultNaming{}
+	assert.Equal(t, plain.TypeName("user_accounts"), "UserAccounts")
+	assert.Equal(t, plain.FieldName("uuid_column"), "UuidColumn")
+	assert.Equal(t, plain.Symbol("ascii_column"), "ASCII_COLUMN")
+	assert.Equal(t, plain.Symbol("_x"), "_X")
+	assert.Equal(t, plain.Symbol("a__b"), "A__B")
+
+	lint := DefaultNaming{Initialisms: true, Prefix: "Db", Suffix: "Row"}
+	assert.Equal(t, lint.TypeName("api_keys"), "DbAPIKeysRow")
+	assert.Equal(t, lint.FieldName("user_id"), "UserID")
+	assert.Equal(t, lint.FieldName("uuid_column"), "UUIDColumn")
+	assert.Equal(t, lint.FieldName("timeuuid"), "Timeuuid")
+
+	assert.Equal(t, escapeIdentifier("2fa"), "X2fa")
+	assert.Equal(t, escapeIdentifier("type"), "type_")
+	assert.Equal(t, escapeIdentifier("CQLC_VERSION"), "CQLC_VERSION_")
+	assert.Equal(t, escapeIdentifier("Basic"), "Basic")
+}
+
+func TestCheckIdentifiers(t *testing.T) {
+
+	table := func(name string, columns ...string) map[string]interface{} {
+		naming := DefaultNaming{}
+		fields := make(map[string]string)
+		symbols := make(map[string]string)
+		for _, column := range columns {
+			fields[column] = naming.FieldName(column)
+			symbols[column] = naming.Symbol(column)
+		}
+		return map[string]interface{}{
+			"Name":          name,
+			"Type":          naming.TypeName(name),
+			"Fields":        fields,
+			"Symbols":       true,
+			"Symbol":        naming.Symbol(name),
+			"ColumnSymbols": symbols,
+		}
+	}
+
+	tables := []map[string]interface{}{table("basic", "id", "value"), table("clone", "id")}
+	assert.NoError(t, checkIdentifiers(tables))
+
+	// The type of basic_def collides with the definition of basic
+	tables = []map[string]interface{}{table("basic", "id"), table("basic_def", "id")}
+	assert.Error(t, checkIdentifiers(tables))
+
+	// Both tables generate the same type
+	tables = []map[string]interface{}{table("user_accounts", "id"), table("user__accounts", "id")}
+	assert.Error(t, checkIdentifiers(tables))
+
+	// The field of status_value collides with the accessor of status
+	tables = []map[string]interface{}{table("events", "status", "status_value")}
+	assert.Error(t, checkIdentifiers(tables))
+}
+
+func TestParseSchemaFile(t *testing.T) {
+
+	src, err := ioutil.ReadFile("../test/schema.cql")
+	assert.NoError(t, err)
+
+	cols, err := parseSchema(string(src))
+	assert.NoError(t, err)
+
+	schema := make(map[string]string)
+	for _, col := range cols {
+		schema[col.Table+"."+col.Column] = col.Type + " " + col.Kind
+	}
+
+	assert.Equal(t, schema["basic.id"], "ascii partition_key")
+	assert.Equal(t, schema["basic.text_column"], "text regular")
+	assert.Equal(t, schema["basic.map_column"], "map<text,text> regular")
+	assert.Equal(t, schema["reverse_timeseries.insertion_time"], "timestamp clustering_key")
+	assert.Equal(t, schema["simple_indexed_composite.y"], "int partition_key")
+	assert.Equal(t, schema["simple_indexed_composite.z"], "int clustering_key")
+	assert.Equal(t, schema["sensor_readings.location"], "text static")
+	assert.Equal(t, schema["orders_by_customer.customer"], "text partition_key")
+	assert.Equal(t, schema["orders_by_customer.id"], "text clustering_key")
+	assert.Equal(t, schema["orders_by_customer.total"], "int regular")
+
+	cols, err = parseSchema(`CREATE TABLE IF NOT EXISTS cqlc."Accounts" ("Name" text PRIMARY KEY, balance bigint);`)
+	assert.NoError(t, err)
+	assert.Equal(t, cols, []SchemaColumn{
+		SchemaColumn{Table: "Accounts", Column: "Name", Type: "text", Kind: "partition_key"},
+		SchemaColumn{Table: "Accounts", Column: "balance", Type: "bigint", Kind: "regular"},
+	})
+
+	_, err = parseSchema(`CREATE TABLE accounts (name text, balance bigint);`)
+	assert.Error(t, err)
+
+	// A view takes the types of the columns it selects from its base table
+	cols, err = parseSchema(`CREATE TABLE events (sensor bigint, at timestamp, value double, note text, PRIMARY KEY (sensor, at));
+		CREATE MATERIALIZED VIEW IF NOT EXISTS cqlc.events_by_time AS SELECT value FROM cqlc.events
+			WHERE at IS NOT NULL AND sensor IS NOT NULL PRIMARY KEY ((at), sensor) WITH CLUSTERING ORDER BY (sensor DESC);`)
+	assert.NoError(t, err)
+	assert.Equal(t, cols[4:], []SchemaColumn{
+		SchemaColumn{Table: "events_by_time", Column: "at", Type: "timestamp", Kind: "partition_key"},
+		SchemaColumn{Table: "events_by_time", Column: "sensor", Type: "bigint", Kind: "clustering_key"},
+		SchemaColumn{Table: "events_by_time", Column: "value", Type: "double", Kind: "regular"},
+	})
+
+	_, err = parseSchema(`CREATE MATERIALIZED VIEW events_by_time AS SELECT * FROM events WHERE at IS NOT NULL PRIMARY KEY (at, sensor);`)
+	assert.Error(t, err)
+}
+
+func TestDiffSchema(t *testing.T) {
+
+	old := []SchemaColumn{
+		SchemaColumn{Table: "events", Column: "pressure", Type: "int", Kind: "regular"},
+		SchemaColumn{Table: "events", Column: "sensor", Type: "bigint", Kind: "partition_key"},
+		SchemaColumn{Table: "events", Column: "temperature", Type: "float", Kind: "regular"},
+	}
+	current := []SchemaColumn{
+		SchemaColumn{Table: "events", Column: "humidity", Type: "float", Kind: "regular"},
+		SchemaColumn{Table: "events", Column: "sensor", Type: "bigint", Kind: "partition_key"},
+		SchemaColumn{Table: "events", Column: "temperature", Type: "double", Kind: "regular"},
+	}
+
+	assert.Equal(t, diffSchema(old, old), []string{})
+	assert.Equal(t, diffSchema(old, current), []string{
+		"+ events.humidity float regular",
+		"- events.pressure int regular",
+		"~ events.temperature float regular -> double regular",
+	})
+}
+
+func TestCheckAgainstSchemaFile(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "cqlc")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	table := &gocql.TableMetadata{
+		Name: "events",
+		Columns: map[string]*gocql.ColumnMetadata{
+			"sensor":      &gocql.ColumnMetadata{Name: "sensor", Kind: gocql.PARTITION_KEY, Type: gocql.NewNativeType(3, gocql.TypeBigInt, "")},
+			"temperature": &gocql.ColumnMetadata{Name: "temperature", Kind: gocql.REGULAR, Type: gocql.NewNativeType(3, gocql.TypeFloat, "")},
+		},
+	}
+	table.PartitionKey = []*gocql.ColumnMetadata{table.Columns["sensor"]}
+	md := &gocql.KeyspaceMetadata{Name: "cqlc", Tables: map[string]*gocql.TableMetadata{"events": table}}
+
+	meta, err := bindingMeta(Provenance{Keyspace: "cqlc"}, &Options{Package: "model"}, md, nil)
+	assert.NoError(t, err)
+
+	var b bytes.Buffer
+	assert.NoError(t, generateBinding(meta, &b))
+
+	output := filepath.Join(dir, "binding.go")
+	assert.NoError(t, ioutil.WriteFile(output, b.Bytes(), 0644))
+
+	check := func(cql string) (string, error) {
+		schema := filepath.Join(dir, "schema.cql")
+		assert.NoError(t, ioutil.WriteFile(schema, []byte(cql), 0644))
+
+		var diff bytes.Buffer
+		err := Check(&Options{Output: output, Schema: schema}, &diff)
+		return diff.String(), err
+	}
+
+	diff, err := check(`CREATE TABLE events (sensor bigint, temperature float, PRIMARY KEY (sensor));`)
+	assert.NoError(t, err)
+	assert.Equal(t, diff, "")
+
+	diff, err = check(`CREATE TABLE events (sensor bigint, temperature double, PRIMARY KEY (sensor));`)
+	assert.Equal(t, err, ErrSchemaDrift)
+	assert.Equal(t, diff, "~ events.temperature float regular -> double regular\n")
+}
@@ -0,0 +1,172 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Naming derives the Go identifiers of the generated code from the names of tables and columns.
+// The identifiers of the generated column types, accessors and functions of a table are all
+// derived from its type name and the field names of its columns.
+type Naming interface {
+	// TypeName returns the name of the row struct of a table
+	TypeName(table string) string
+	// FieldName returns the name of the row struct field of a column
+	FieldName(column string) string
+	// Symbol returns the name of the compile symbol of a table or of the definition field of a column
+	Symbol(name string) string
+}
+
+// DefaultNaming camel cases type and field names and upper cases symbols.
+type DefaultNaming struct {
+	// Initialisms upper cases the common initialisms that golint knows about, e.g. ID and UUID
+	Initialisms bool
+	// Prefix is prepended to the name of each row struct
+	Prefix string
+	// Suffix is appended to the name of each row struct
+	Suffix string
+}
+
+// initialisms are the words that golint expects to be upper cased.
+var initialisms = map[string]bool{
+	"ACL": true, "API": true, "ASCII": true, "CPU": true, "CSS": true, "DNS": true,
+	"EOF": true, "GUID": true, "HTML": true, "HTTP": true, "HTTPS": true, "ID": true,
+	"IP": true, "JSON": true, "LHS": true, "QPS": true, "RAM": true, "RHS": true,
+	"RPC": true, "SLA": true, "SMTP": true, "SQL": true, "SSH": true, "TCP": true,
+	"TLS": true, "TTL": true, "UDP": true, "UI": true, "UID": true, "UUID": true,
+	"URI": true, "URL": true, "UTF8": true, "VM": true, "XML": true, "XMPP": true,
+	"XSRF": true, "XSS": true,
+}
+
+func (n DefaultNaming) TypeName(table string) string {
+	return n.Prefix + n.camel(table) + n.Suffix
+}
+
+func (n DefaultNaming) FieldName(column string) string {
+	return n.camel(column)
+}
+
+func (n DefaultNaming) Symbol(name string) string {
+	return strings.ToUpper(name)
+}
+
+func (n DefaultNaming) camel(name string) string {
+	if !n.Initialisms {
+		return snakeToCamel(name)
+	}
+
+	words := camelRegex.FindAllString(name, -1)
+	for i, word := range words {
+		if upper := strings.ToUpper(word); initialisms[upper] {
+			words[i] = upper
+		} else {
+			words[i] = snakeToCamel(word)
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// reservedIdentifiers are declared by the generated code itself.
+var reservedIdentifiers = map[string]bool{
+	"CQLC_VERSION": true,
+}
+
+// namingStrategy returns the naming strategy of the options, which defaults to DefaultNaming.
+func namingStrategy(opts *Options) Naming {
+	if opts.Naming != nil {
+		return opts.Naming
+	}
+	return DefaultNaming{
+		Initialisms: opts.Initialisms,
+		Prefix:      opts.TypePrefix,
+		Suffix:      opts.TypeSuffix,
+	}
+}
+
+// escapeIdentifier turns a name into a valid Go identifier that does not clash with a keyword
+// or with an identifier of the generated code.
+func escapeIdentifier(name string) string {
+	if name == "" {
+		return "X"
+	}
+	if first := []rune(name)[0]; !unicode.IsLetter(first) && first != '_' {
+		name = "X" + name
+	}
+	if token.Lookup(name).IsKeyword() || reservedIdentifiers[name] {
+		name = name + "_"
+	}
+	return name
+}
+
+// identifiers tracks which table and column each generated identifier of a scope belongs to.
+type identifiers map[string]string
+
+func (ids identifiers) declare(name, owner string) error {
+	if other, ok := ids[name]; ok && other != owner {
+		return fmt.Errorf("Both %s and %s generate the Go identifier %s", other, owner, name)
+	}
+	ids[name] = owner
+	return nil
+}
+
+// checkIdentifiers returns an error if two tables or columns generate the same Go identifier,
+// either at the package level or within the row struct or definition of a table.
+// Each table is described by its context from bindingMeta.
+func checkIdentifiers(tables []map[string]interface{}) error {
+	pkg := identifiers{"CQLC_VERSION": "cqlc"}
+
+	for _, table := range tables {
+		name := table["Name"].(string)
+		structType := table["Type"].(string)
+		fields := table["Fields"].(map[string]string)
+		symbols := table["ColumnSymbols"].(map[string]string)
+
+		declared := []string{structType, structType + "Def", "Bind" + structType, "Map" + structType, structType + "TableDef"}
+		if table["Symbols"].(bool) {
+			declared = append(declared, table["Symbol"].(string))
+		}
+		for _, id := range declared {
+			if err := pkg.declare(id, "table "+name); err != nil {
+				return err
+			}
+		}
+
+		row := identifiers{}
+		def := identifiers{}
+		for _, method := range []string{"TableName", "Keyspace", "Bind", "To", "ColumnDefinitions", "PartitionKeyColumns",
+			"ClusteringColumns", "SupportsUpsert", "IsCounterTable", "ApplyCounterDelta", "IsView"} {
+			def[method] = "cqlc"
+		}
+
+		columns := make([]string, 0, len(fields))
+		for column := range fields {
+			columns = append(columns, column)
+		}
+		sort.Strings(columns)
+
+		for _, column := range columns {
+			owner := "column " + name + "." + column
+			field := fields[column]
+			checks := []struct {
+				scope identifiers
+				id    string
+			}{
+				{pkg, structType + field + "Column"},
+				{row, field},
+				{row, field + "Value"},
+				{def, symbols[column]},
+				{def, field + "Column"},
+			}
+			for _, check := range checks {
+				if err := check.scope.declare(check.id, owner); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -26,6 +26,24 @@ var literalTypes = map[gocql.Type]string{
 	gocql.TypeVarint:    "*big.Int",
 }
 
+// cqlTypes resolves the CQL type names that can be mapped to custom Go types.
+var cqlTypes = map[string]gocql.Type{
+	"ascii":     gocql.TypeAscii,
+	"text":      gocql.TypeVarchar,
+	"varchar":   gocql.TypeVarchar,
+	"int":       gocql.TypeInt,
+	"bigint":    gocql.TypeBigInt,
+	"float":     gocql.TypeFloat,
+	"double":    gocql.TypeDouble,
+	"timestamp": gocql.TypeTimestamp,
+	"timeuuid":  gocql.TypeTimeUUID,
+	"uuid":      gocql.TypeUUID,
+	"boolean":   gocql.TypeBoolean,
+	"blob":      gocql.TypeBlob,
+	"decimal":   gocql.TypeDecimal,
+	"varint":    gocql.TypeVarint,
+}
+
 var customImportPaths = map[string]string{
 	"gocql.UUID": "github.com/gocql/gocql",
 	"*inf.Dec":   "gopkg.in/inf.v0",
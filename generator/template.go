@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/gocql/gocql"
+	"github.com/relops/cqlc/cqlc"
 	"regexp"
 	"strings"
 	"text/template"
@@ -18,12 +19,16 @@ func init() {
 		"sprint":                fmt.Sprint,
 		"snakeToCamel":          snakeToCamel,
 		"columnType":            columnType,
+		"cqlType":               cqlc.TypeName,
 		"valueType":             valueType,
 		"isCounterColumn":       isCounterColumn,
+		"isStaticColumn":        isStaticColumn,
 		"supportsClustering":    supportsClustering,
 		"supportsPartitioning":  supportsPartitioning,
 		"isListType":            isListType,
+		"isTimeUUID":            isTimeUUID,
 		"hasSecondaryIndex":     hasSecondaryIndex,
+		"isFilterable":          isFilterable,
 		"isLastComponent":       isLastComponent,
 		"isCounterColumnFamily": isCounterColumnFamily,
 	}
@@ -46,6 +51,10 @@ func isCounterColumn(c gocql.ColumnMetadata) bool {
 	return c.Type.Type() == gocql.TypeCounter
 }
 
+func isStaticColumn(c gocql.ColumnMetadata) bool {
+	return c.Kind == gocql.STATIC
+}
+
 func supportsClustering(c gocql.ColumnMetadata) bool {
 	return c.Kind == gocql.CLUSTERING_KEY
 }
@@ -71,10 +80,27 @@ func isListType(c gocql.ColumnMetadata) bool {
 	return c.Type.Type() == gocql.TypeList || c.Type.Type() == gocql.TypeSet
 }
 
+func isTimeUUID(c gocql.ColumnMetadata) bool {
+	return c.Type.Type() == gocql.TypeTimeUUID
+}
+
 func hasSecondaryIndex(c gocql.ColumnMetadata) bool {
 	return c.Index.Name != ""
 }
 
+// isFilterable returns true for regular columns without a secondary index,
+// which can only be restricted in queries that allow filtering.
+func isFilterable(c gocql.ColumnMetadata) bool {
+	if c.Kind == gocql.PARTITION_KEY || c.Kind == gocql.CLUSTERING_KEY || c.Kind == gocql.STATIC {
+		return false
+	}
+	switch c.Type.Type() {
+	case gocql.TypeCounter, gocql.TypeList, gocql.TypeSet, gocql.TypeMap:
+		return false
+	}
+	return !hasSecondaryIndex(c)
+}
+
 func columnType(c gocql.ColumnMetadata, table *gocql.TableMetadata) string {
 
 	t := c.Type
@@ -94,9 +120,15 @@ func columnType(c gocql.ColumnMetadata, table *gocql.TableMetadata) string {
 			replacement = ".LastPartitioned"
 		}
 		baseType = strings.Replace(baseType, ".", replacement, 1)
+	} else if c.Kind == gocql.STATIC {
+		replacement := ".Static"
+		baseType = strings.Replace(baseType, ".", replacement, 1)
 	} else if c.Index.Name != "" {
 		replacement := ".Equality"
 		baseType = strings.Replace(baseType, ".", replacement, 1)
+	} else if isFilterable(c) {
+		replacement := ".Filterable"
+		baseType = strings.Replace(baseType, ".", replacement, 1)
 	}
 
 	switch t.Type() {
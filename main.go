@@ -19,6 +19,11 @@ func init() {
 
 func main() {
 
+	if len(os.Args) > 1 && os.Args[1] == "check" {
+		check()
+		return
+	}
+
 	if _, err := parser.Parse(); err != nil {
 		os.Exit(1)
 	}
@@ -33,6 +38,28 @@ func main() {
 	}
 }
 
+// check exits non-zero if the generated code does not match the schema,
+// e.g. cqlc check -i 127.0.0.1 -o model/binding.go
+func check() {
+
+	if _, err := parser.ParseArgs(os.Args[2:]); err != nil {
+		os.Exit(1)
+	}
+
+	if err := generator.Check(&opts, os.Stdout); err != nil {
+		switch err {
+		case generator.ErrInvalidOptions:
+			parser.WriteHelp(os.Stderr)
+			os.Exit(1)
+		case generator.ErrSchemaDrift:
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		default:
+			log.Fatalln(err)
+		}
+	}
+}
+
 func printVersionAndExit() {
 	fmt.Fprintf(os.Stderr, "%s %s\n", "cqlc", VERSION)
 	os.Exit(0)
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"github.com/gocql/gocql"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+)
+
+func main() {
+
+	session := integration.TestSession("127.0.0.1", "cqlc")
+	integration.Truncate(session, EVENTS)
+	integration.Truncate(session, BASIC_COUNTER)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+	batch := cqlc.NewBatchBuilder().MaxStatements(50)
+
+	events := 1000
+
+	for i := 0; i < events; i++ {
+		err := ctx.Upsert(EVENTS).
+			SetInt64(EVENTS.SENSOR, int64(i%10)).
+			SetTimeUUID(EVENTS.TIMESTAMP, gocql.TimeUUID()).
+			SetFloat32(EVENTS.TEMPERATURE, 19.8).
+			SetInt32(EVENTS.PRESSURE, 357).
+			AddTo(batch)
+
+		if err != nil {
+			log.Fatalf("Could not add statement to batch: %v", err)
+			os.Exit(1)
+		}
+	}
+
+	err := ctx.UpdateCounter(BASIC_COUNTER).
+		Increment(BASIC_COUNTER.COUNTER_COLUMN, 1).
+		Having(BASIC_COUNTER.ID.Eq("x")).
+		AddTo(batch)
+
+	if err != cqlc.ErrMixedBatch {
+		log.Fatalf("Expected mixed batch error, got: %v", err)
+		os.Exit(1)
+	}
+
+	if err := batch.Exec(session); err != nil {
+		log.Fatalf("Could not execute batch: %v", err)
+		os.Exit(1)
+	}
+
+	iter, err := ctx.Select().From(EVENTS).Fetch(session)
+	if err != nil {
+		log.Fatalf("Could not fetch events: %v", err)
+		os.Exit(1)
+	}
+
+	count := 0
+
+	MapEvents(iter, func(e Events) (bool, error) {
+		count++
+		return true, nil
+	})
+
+	if err := iter.Close(); err != nil {
+		log.Fatalf("Could not close iterator: %v", err)
+		os.Exit(1)
+	}
+
+	if count == events && batch.Len() == 0 {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("Expected %d events, but found %d", events, count)
+	}
+
+	os.Stdout.WriteString(result)
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"github.com/gocql/gocql"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+
+	session := integration.TestSession("127.0.0.1", "cqlc")
+	integration.Truncate(session, REALLY_BASIC)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+
+	// A batch written in the future cannot be overwritten by a statement that uses the current time
+	future := time.Now().Add(time.Hour).UnixNano() / int64(time.Microsecond)
+	batch := cqlc.NewBatchBuilder().Timestamp(future)
+
+	err := ctx.Upsert(REALLY_BASIC).
+		SetString(REALLY_BASIC.ID, "t").
+		SetInt32(REALLY_BASIC.INT32_COLUMN, 1).
+		AddTo(batch)
+
+	if err != nil {
+		log.Fatalf("Could not add statement to batch: %v", err)
+		os.Exit(1)
+	}
+
+	if err := batch.Exec(session); err != nil {
+		log.Fatalf("Could not execute batch: %v", err)
+		os.Exit(1)
+	}
+
+	err = ctx.Upsert(REALLY_BASIC).
+		SetString(REALLY_BASIC.ID, "t").
+		SetInt32(REALLY_BASIC.INT32_COLUMN, 2).
+		Exec(session)
+
+	if err != nil {
+		log.Fatalf("Could not upsert: %v", err)
+		os.Exit(1)
+	}
+
+	if value := readValue(session, "t"); value != 1 {
+		result = fmt.Sprintf("Expected the batch timestamp to win, but value was %d", value)
+		os.Stdout.WriteString(result)
+		return
+	}
+
+	applied, _ := conditionalBatch(session, "c", 10)
+	if !applied {
+		os.Stdout.WriteString("Expected the first conditional batch to be applied")
+		return
+	}
+
+	applied, current := conditionalBatch(session, "c", 20)
+	if !applied && current == 10 {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("Expected the second conditional batch to fail with the current value (applied: %v, value: %d)", applied, current)
+	}
+
+	os.Stdout.WriteString(result)
+}
+
+func conditionalBatch(session *gocql.Session, id string, value int32) (bool, int32) {
+
+	var casId string
+	var casInt32column int32
+
+	ctx := cqlc.NewContext()
+	batch := cqlc.NewBatchBuilder()
+
+	err := ctx.Upsert(REALLY_BASIC).
+		SetString(REALLY_BASIC.ID, id).
+		SetInt32(REALLY_BASIC.INT32_COLUMN, value).
+		IfExists(REALLY_BASIC.ID.To(&casId), REALLY_BASIC.INT32_COLUMN.To(&casInt32column)).
+		AddTo(batch)
+
+	if err != nil {
+		log.Fatalf("Could not add conditional statement to batch: %v", err)
+		os.Exit(1)
+	}
+
+	applied, err := batch.Swap(session)
+	if err != nil {
+		log.Fatalf("Could not execute conditional batch: %v", err)
+		os.Exit(1)
+	}
+
+	return applied, casInt32column
+}
+
+func readValue(session *gocql.Session, id string) int32 {
+
+	var value int32
+
+	ctx := cqlc.NewContext()
+	_, err := ctx.Select(REALLY_BASIC.INT32_COLUMN).
+		From(REALLY_BASIC).
+		Where(REALLY_BASIC.ID.Eq(id)).
+		Bind(REALLY_BASIC.INT32_COLUMN.To(&value)).
+		FetchOne(session)
+
+	if err != nil {
+		log.Fatalf("Could not read value: %v", err)
+		os.Exit(1)
+	}
+
+	return value
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"github.com/gocql/gocql"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+)
+
+func main() {
+
+	session := integration.TestSession("127.0.0.1", "cqlc")
+	integration.Truncate(session, EVENTS)
+
+	result := "FAILED"
+
+	sensors := 20
+	readings := 50
+
+	bindings := make([]cqlc.TableBinding, 0, sensors*readings)
+	for i := 0; i < sensors*readings; i++ {
+		e := Events{
+			Sensor:      int64(i % sensors),
+			Timestamp:   gocql.TimeUUID(),
+			Temperature: 19.8,
+			Pressure:    357,
+		}
+		bindings = append(bindings, EVENTS.Bind(e))
+	}
+
+	err := cqlc.NewBulkWriter().Parallelism(8).Write(session, bindings...)
+	if err != nil {
+		log.Fatalf("Could not write events: %v", err)
+		os.Exit(1)
+	}
+
+	ctx := cqlc.NewContext()
+	iter, err := ctx.Select().From(EVENTS).Where(EVENTS.SENSOR.Eq(7)).Fetch(session)
+	if err != nil {
+		log.Fatalf("Could not fetch events: %v", err)
+		os.Exit(1)
+	}
+
+	events, err := BindEvents(iter)
+	if err != nil {
+		log.Fatalf("Could not bind events: %v", err)
+		os.Exit(1)
+	}
+
+	if err := iter.Close(); err != nil {
+		log.Fatalf("Could not close iterator: %v", err)
+		os.Exit(1)
+	}
+
+	if len(events) == readings {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("Expected %d readings for sensor 7, but found %d", readings, len(events))
+	}
+
+	os.Stdout.WriteString(result)
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"github.com/gocql/gocql"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+)
+
+var COUNTER = BASIC_COUNTER
+
+func main() {
+	session := integration.TestSession("127.0.0.1", "cqlc")
+	integration.Truncate(session, COUNTER)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+
+	err := ctx.UpdateCounter(COUNTER).
+		Increment(COUNTER.COUNTER_COLUMN, 20).
+		Having(COUNTER.ID.Eq("x")).
+		Exec(session)
+
+	if err != nil {
+		log.Fatalf("Could not execute counter increment: %v", err)
+	}
+
+	err = ctx.UpdateCounter(COUNTER).
+		Decrement(COUNTER.COUNTER_COLUMN, 5).
+		Having(COUNTER.ID.Eq("x")).
+		Exec(session)
+
+	if err != nil {
+		log.Fatalf("Could not execute counter decrement: %v", err)
+	}
+
+	counter := readCounter(session, "x")
+	if counter != 15 {
+		result = fmt.Sprintf("Expected 15 after decrement, but counter was %d", counter)
+		os.Stdout.WriteString(result)
+		return
+	}
+
+	old := BasicCounter{Id: "x", CounterColumn: 15}
+	updated := BasicCounter{Id: "x", CounterColumn: 9}
+
+	err = ctx.Add(COUNTER.ApplyCounterDelta(old, updated)).Exec(session)
+
+	if err != nil {
+		log.Fatalf("Could not apply counter delta: %v", err)
+	}
+
+	counter = readCounter(session, "x")
+	if counter == 9 {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("Expected 9 after delta, but counter was %d", counter)
+	}
+
+	os.Stdout.WriteString(result)
+}
+
+func readCounter(session *gocql.Session, key string) int64 {
+
+	var counter int64
+
+	ctx := cqlc.NewContext()
+	_, err := ctx.Select(COUNTER.COUNTER_COLUMN).
+		From(COUNTER).
+		Where(COUNTER.ID.Eq(key)).
+		Bind(COUNTER.COUNTER_COLUMN.To(&counter)).
+		FetchOne(session)
+
+	if err != nil {
+		log.Fatalf("Could not bind data: %v", err)
+		os.Exit(1)
+	}
+
+	return counter
+}
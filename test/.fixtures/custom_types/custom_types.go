@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"github.com/gocql/gocql"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"gopkg.in/inf.v0"
+	"log"
+	"os"
+)
+
+// Money is stored as a decimal with two fractional digits
+type Money struct {
+	Cents int64
+}
+
+func (m Money) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return gocql.Marshal(info, inf.NewDec(m.Cents, 2))
+}
+
+func (m *Money) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	dec := new(inf.Dec)
+	if err := gocql.Unmarshal(info, data, &dec); err != nil {
+		return err
+	}
+	m.Cents = dec.Round(dec, 2, inf.RoundHalfEven).UnscaledBig().Int64()
+	return nil
+}
+
+// PaymentID is a uuid key that is bound by its own type
+type PaymentID gocql.UUID
+
+func (id PaymentID) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return gocql.Marshal(info, gocql.UUID(id))
+}
+
+func (id *PaymentID) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return gocql.Unmarshal(info, data, (*gocql.UUID)(id))
+}
+
+// PaymentStatus is stored as text, which gocql marshals from the underlying string
+type PaymentStatus string
+
+const (
+	Pending PaymentStatus = "pending"
+	Settled PaymentStatus = "settled"
+)
+
+func main() {
+
+	session := integration.TestSession("127.0.0.1", "cqlc")
+	integration.Truncate(session, PAYMENTS)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+
+	id := PaymentID(gocql.TimeUUID())
+
+	payment := Payments{
+		Id:     id,
+		Amount: Money{Cents: 1999},
+		Status: Pending,
+	}
+
+	if err := ctx.Store(PAYMENTS.Bind(payment)).Exec(session); err != nil {
+		log.Fatalf("Could not store payment: %v", err)
+		os.Exit(1)
+	}
+
+	err := ctx.Upsert(PAYMENTS).
+		Apply(PAYMENTS.STATUS.Set(Settled)).
+		Where(PAYMENTS.ID.Eq(id)).
+		Exec(session)
+
+	if err != nil {
+		log.Fatalf("Could not settle payment: %v", err)
+		os.Exit(1)
+	}
+
+	var amount Money
+	var status PaymentStatus
+
+	found, err := ctx.Select().
+		From(PAYMENTS).
+		Where(PAYMENTS.ID.In(id, PaymentID(gocql.TimeUUID()))).
+		Bind(PAYMENTS.AMOUNT.To(&amount), PAYMENTS.STATUS.To(&status)).
+		FetchOne(session)
+
+	if err != nil {
+		log.Fatalf("Could not read payment: %v", err)
+		os.Exit(1)
+	}
+
+	if found && amount.Cents == 1999 && status == Settled {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("Payment was %v (%s)", amount, status)
+	}
+
+	os.Stdout.WriteString(result)
+}
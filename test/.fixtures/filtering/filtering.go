@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+
+	session := integration.TestSession("127.0.0.1", "cqlc")
+	integration.Truncate(session, USER_ACCOUNTS)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+
+	accounts := []UserAccounts{
+		UserAccounts{Username: "a", Email: "a@example.com", Country: "uk", LastVisited: time.Now()},
+		UserAccounts{Username: "b", Email: "b@example.com", Country: "uk", LastVisited: time.Now()},
+		UserAccounts{Username: "c", Email: "a@example.com", Country: "de", LastVisited: time.Now()},
+	}
+
+	for _, account := range accounts {
+		if err := ctx.Store(USER_ACCOUNTS.Bind(account)).Exec(session); err != nil {
+			log.Fatalf("Could not store account: %v", err)
+			os.Exit(1)
+		}
+	}
+
+	iter, err := ctx.Select().
+		From(USER_ACCOUNTS).
+		Where(USER_ACCOUNTS.COUNTRY.Eq("uk")).
+		AllowFiltering(USER_ACCOUNTS.EMAIL.Eq("a@example.com")).
+		Fetch(session)
+
+	if err != nil {
+		log.Fatalf("Could not prepare query: %v", err)
+		os.Exit(1)
+	}
+
+	filtered, err := BindUserAccounts(iter)
+	if err != nil {
+		log.Fatalf("Could not bind accounts: %v", err)
+		os.Exit(1)
+	}
+
+	if err := iter.Close(); err != nil {
+		log.Fatalf("Could not close iterator: %v", err)
+		os.Exit(1)
+	}
+
+	if len(filtered) == 1 && filtered[0].Username == "a" {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("Expected account a; got %+v", filtered)
+	}
+
+	os.Stdout.WriteString(result)
+}
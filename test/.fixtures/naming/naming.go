@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+	"reflect"
+)
+
+func main() {
+
+	s := integration.TestSession("127.0.0.1", "cqlc")
+	cqlc.Truncate(s, REALLY_BASIC)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+
+	// Initialisms are upper cased and each row struct has a suffix
+	basic := ReallyBasicRow{
+		ID:          "x",
+		Int32Column: 7,
+	}
+
+	if err := ctx.Store(REALLY_BASIC.Bind(basic)).Exec(s); err != nil {
+		log.Fatalf("Could not store data: %v", err)
+		os.Exit(1)
+	}
+
+	var fetched ReallyBasicRow
+	found, err := ctx.Select().
+		From(REALLY_BASIC).
+		Where(REALLY_BASIC.ID.Eq("x")).
+		Into(REALLY_BASIC.To(&fetched)).
+		FetchOne(s)
+
+	if err != nil {
+		log.Fatalf("Could not retrieve data: %v", err)
+		os.Exit(1)
+	}
+
+	if found && reflect.DeepEqual(fetched, basic) && fetched.IDValue() == "x" {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("[%+v] [%+v]", fetched, basic)
+	}
+
+	os.Stdout.WriteString(result)
+}
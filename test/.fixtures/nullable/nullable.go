@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"github.com/gocql/gocql"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+)
+
+func main() {
+
+	s := integration.TestSession("127.0.0.1", "cqlc")
+	cqlc.Truncate(s, REALLY_BASIC)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+
+	// A nil field reads back as nil rather than as a zero value
+	if err := ctx.Store(REALLY_BASIC.Bind(ReallyBasic{Id: "x"})).Exec(s); err != nil {
+		log.Fatalf("Could not store data: %v", err)
+		os.Exit(1)
+	}
+
+	missing, err := fetch(ctx, s, "x")
+	if err != nil {
+		log.Fatalf("Could not retrieve data: %v", err)
+		os.Exit(1)
+	}
+
+	// A nil field is not written, so it leaves the stored value alone
+	value := int32(2001)
+
+	if err := ctx.Store(REALLY_BASIC.Bind(ReallyBasic{Id: "y", Int32Column: &value})).Exec(s); err != nil {
+		log.Fatalf("Could not store data: %v", err)
+		os.Exit(1)
+	}
+
+	if err := ctx.Store(REALLY_BASIC.Bind(ReallyBasic{Id: "y"})).Exec(s); err != nil {
+		log.Fatalf("Could not store data: %v", err)
+		os.Exit(1)
+	}
+
+	present, err := fetch(ctx, s, "y")
+	if err != nil {
+		log.Fatalf("Could not retrieve data: %v", err)
+		os.Exit(1)
+	}
+
+	// A nullable column is still set and filtered on by its value type
+	err = ctx.Upsert(REALLY_BASIC).
+		SetInt32(REALLY_BASIC.INT32_COLUMN, 2002).
+		Where(REALLY_BASIC.ID.Eq("z")).
+		Exec(s)
+
+	if err != nil {
+		log.Fatalf("Could not upsert data: %v", err)
+		os.Exit(1)
+	}
+
+	var filtered ReallyBasic
+
+	found, err := ctx.Select().
+		From(REALLY_BASIC).
+		Where(REALLY_BASIC.ID.Eq("z")).
+		AllowFiltering(REALLY_BASIC.INT32_COLUMN.Eq(2002)).
+		Into(REALLY_BASIC.To(&filtered)).
+		FetchOne(s)
+
+	if err != nil {
+		log.Fatalf("Could not retrieve data: %v", err)
+		os.Exit(1)
+	}
+
+	if missing.Int32Column == nil && present.Int32Column != nil && *present.Int32Column == value &&
+		found && filtered.Int32Column != nil && *filtered.Int32Column == 2002 {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("[%+v] [%+v] [%+v]", missing, present, filtered)
+	}
+
+	os.Stdout.WriteString(result)
+}
+
+func fetch(ctx *cqlc.Context, s *gocql.Session, id string) (ReallyBasic, error) {
+	var fetched ReallyBasic
+	_, err := ctx.Select().
+		From(REALLY_BASIC).
+		Where(REALLY_BASIC.ID.Eq(id)).
+		Into(REALLY_BASIC.To(&fetched)).
+		FetchOne(s)
+	return fetched, err
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"github.com/gocql/gocql"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+	"sync"
+)
+
+func main() {
+
+	session := integration.TestSession("127.0.0.1", "cqlc")
+	integration.Truncate(session, EVENTS)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+	batch := gocql.NewBatch(gocql.UnloggedBatch)
+
+	sensors := 100
+
+	for i := 0; i < sensors; i++ {
+		ctx.Upsert(EVENTS).
+			SetInt64(EVENTS.SENSOR, int64(i)).
+			SetTimeUUID(EVENTS.TIMESTAMP, gocql.TimeUUID()).
+			SetFloat32(EVENTS.TEMPERATURE, 19.8).
+			SetInt32(EVENTS.PRESSURE, 357).
+			Batch(batch)
+	}
+
+	if err := session.ExecuteBatch(batch); err != nil {
+		log.Fatalf("Could not execute batch: %v", err)
+		os.Exit(1)
+	}
+
+	var mu sync.Mutex
+	seen := make(map[int64]bool)
+
+	err := cqlc.Scan(EVENTS).
+		Splits(8).
+		Parallelism(3).
+		Each(session, func(r cqlc.TokenRange, iter *gocql.Iter) error {
+			return MapEvents(iter, func(e Events) (bool, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				seen[e.Sensor] = true
+				return true, nil
+			})
+		})
+
+	if err != nil {
+		log.Fatalf("Could not scan table: %v", err)
+		os.Exit(1)
+	}
+
+	if len(seen) == sensors {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("Expected %d sensors; got %d", sensors, len(seen))
+	}
+
+	os.Stdout.WriteString(result)
+}
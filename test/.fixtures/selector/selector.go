@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"github.com/gocql/gocql"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+
+	session := integration.TestSession("127.0.0.1", "cqlc")
+	integration.Truncate(session, EVENTS)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+
+	now := time.Now().Truncate(time.Millisecond)
+
+	err := ctx.Upsert(EVENTS).
+		SetInt64(EVENTS.SENSOR, 100).
+		SetTimeUUID(EVENTS.TIMESTAMP, gocql.UUIDFromTime(now)).
+		SetFloat32(EVENTS.TEMPERATURE, 19.8).
+		SetInt32(EVENTS.PRESSURE, 357).
+		Exec(session)
+
+	if err != nil {
+		log.Fatalf("Could not upsert event: %v", err)
+		os.Exit(1)
+	}
+
+	var ts time.Time
+	var temp float32
+
+	when := cqlc.DateOf(EVENTS.TIMESTAMP).As("ts")
+	temperature := cqlc.AliasFloat32(EVENTS.TEMPERATURE, "temp")
+
+	found, err := ctx.Select(when, temperature).
+		From(EVENTS).
+		Where(EVENTS.SENSOR.Eq(100)).
+		Bind(when.To(&ts), temperature.To(&temp)).
+		FetchOne(session)
+
+	if err != nil {
+		log.Fatalf("Could not fetch event: %v", err)
+		os.Exit(1)
+	}
+
+	if found && ts.Equal(now) && temp == 19.8 {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("Expected %v and 19.8; got %v and %v", now, ts, temp)
+	}
+
+	os.Stdout.WriteString(result)
+}
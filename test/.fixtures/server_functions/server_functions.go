@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"github.com/gocql/gocql"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+
+	session := integration.TestSession("127.0.0.1", "cqlc")
+	integration.Truncate(session, EVENTS)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+
+	err := ctx.Upsert(EVENTS).
+		SetInt64(EVENTS.SENSOR, 100).
+		SetTimeUUIDFunction(EVENTS.TIMESTAMP, cqlc.Now()).
+		SetFloat32(EVENTS.TEMPERATURE, 19.8).
+		SetInt32(EVENTS.PRESSURE, 357).
+		Exec(session)
+
+	if err != nil {
+		log.Fatalf("Could not upsert event: %v", err)
+		os.Exit(1)
+	}
+
+	var timestamp gocql.UUID
+
+	found, err := ctx.Select(EVENTS.TIMESTAMP).
+		From(EVENTS).
+		Where(EVENTS.SENSOR.Eq(100)).
+		Bind(EVENTS.TIMESTAMP.To(&timestamp)).
+		FetchOne(session)
+
+	if err != nil {
+		log.Fatalf("Could not fetch event: %v", err)
+		os.Exit(1)
+	}
+
+	skew := time.Since(timestamp.Time())
+
+	if found && skew > -time.Minute && skew < time.Minute {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("Expected a recent timeuuid; got %v", timestamp.Time())
+	}
+
+	os.Stdout.WriteString(result)
+}
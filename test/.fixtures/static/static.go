@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+)
+
+func main() {
+
+	session := integration.TestSession("127.0.0.1", "cqlc")
+	integration.Truncate(session, SENSOR_READINGS)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+
+	for i := 0; i < 5; i++ {
+		err := ctx.Upsert(SENSOR_READINGS).
+			SetInt64(SENSOR_READINGS.SENSOR, 1).
+			SetInt32(SENSOR_READINGS.READING, int32(i)).
+			SetFloat64(SENSOR_READINGS.VALUE, float64(i)*1.5).
+			Exec(session)
+
+		if err != nil {
+			log.Fatalf("Could not upsert reading: %v", err)
+			os.Exit(1)
+		}
+	}
+
+	err := ctx.UpdateStatic(SENSOR_READINGS).
+		SetStaticString(SENSOR_READINGS.LOCATION, "roof").
+		SetStaticInt32(SENSOR_READINGS.FIRMWARE, 3).
+		Having(SENSOR_READINGS.SENSOR.Eq(1)).
+		Exec(session)
+
+	if err != nil {
+		log.Fatalf("Could not update static columns: %v", err)
+		os.Exit(1)
+	}
+
+	iter, err := ctx.SelectDistinct(SENSOR_READINGS.SENSOR).Static(SENSOR_READINGS.LOCATION, SENSOR_READINGS.FIRMWARE).
+		From(SENSOR_READINGS).
+		Fetch(session)
+
+	if err != nil {
+		log.Fatalf("Could not select distinct: %v", err)
+		os.Exit(1)
+	}
+
+	readings, err := BindSensorReadings(iter)
+	if err != nil {
+		log.Fatalf("Could not bind readings: %v", err)
+		os.Exit(1)
+	}
+
+	if err := iter.Close(); err != nil {
+		log.Fatalf("Could not close iterator: %v", err)
+		os.Exit(1)
+	}
+
+	if len(readings) == 1 && readings[0].Location == "roof" && readings[0].Firmware == 3 {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("Expected a single partition with static values, got %+v", readings)
+	}
+
+	os.Stdout.WriteString(result)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+)
+
+func main() {
+
+	s := integration.TestSession("127.0.0.1", "cqlc")
+	cqlc.Truncate(s, REALLY_BASIC)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+
+	basic := ReallyBasic{
+		Id:          "x",
+		Int32Column: 42,
+	}
+
+	if err := ctx.Store(REALLY_BASIC.Bind(basic)).Exec(s); err != nil {
+		log.Fatalf("Could not store data: %v", err)
+		os.Exit(1)
+	}
+
+	var fetched ReallyBasic
+	found, err := ctx.Select().
+		From(REALLY_BASIC).
+		Where(REALLY_BASIC.ID.Eq("x")).
+		Into(REALLY_BASIC.To(&fetched)).
+		FetchOne(s)
+
+	if err != nil {
+		log.Fatalf("Could not retrieve data: %v", err)
+		os.Exit(1)
+	}
+
+	// The generated struct tags name the JSON properties after the columns
+	b, err := json.Marshal(fetched)
+	if err != nil {
+		log.Fatalf("Could not encode data: %v", err)
+		os.Exit(1)
+	}
+
+	if found && string(b) == `{"id":"x","int32Column":42}` {
+		result = "PASSED"
+	} else {
+		result = string(b)
+	}
+
+	os.Stdout.WriteString(result)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"github.com/gocql/gocql"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+
+	session := integration.TestSession("127.0.0.1", "cqlc")
+	integration.Truncate(session, EVENTS)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+	now := time.Now()
+
+	for i := 1; i <= 3; i++ {
+		when := now.Add(time.Duration(-i) * time.Hour)
+
+		err := ctx.Upsert(EVENTS).
+			SetInt64(EVENTS.SENSOR, 100).
+			SetTimeUUID(EVENTS.TIMESTAMP, gocql.UUIDFromTime(when)).
+			SetFloat32(EVENTS.TEMPERATURE, 19.8).
+			SetInt32(EVENTS.PRESSURE, 357).
+			Exec(session)
+
+		if err != nil {
+			log.Fatalf("Could not upsert event: %v", err)
+			os.Exit(1)
+		}
+	}
+
+	sensor := EVENTS.SENSOR.Eq(100)
+
+	between := count(session, sensor, EVENTS.TIMESTAMP.Between(now.Add(-150*time.Minute), now.Add(-30*time.Minute)))
+	after := count(session, sensor, EVENTS.TIMESTAMP.After(now.Add(-90*time.Minute)))
+	before := count(session, sensor, EVENTS.TIMESTAMP.Before(now.Add(-150*time.Minute)))
+
+	if between == 2 && after == 1 && before == 1 {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("Expected 2, 1 and 1 events; got %d, %d and %d", between, after, before)
+	}
+
+	os.Stdout.WriteString(result)
+}
+
+func count(session *gocql.Session, conds ...cqlc.Condition) int {
+
+	ctx := cqlc.NewContext()
+	iter, err := ctx.Select().From(EVENTS).Where(conds...).Fetch(session)
+	if err != nil {
+		log.Fatalf("Could not prepare query: %v", err)
+		os.Exit(1)
+	}
+
+	events, err := BindEvents(iter)
+	if err != nil {
+		log.Fatalf("Could not bind events: %v", err)
+		os.Exit(1)
+	}
+
+	if err := iter.Close(); err != nil {
+		log.Fatalf("Could not close iterator: %v", err)
+		os.Exit(1)
+	}
+
+	return len(events)
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"github.com/gocql/gocql"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+)
+
+func main() {
+
+	session := integration.TestSession("127.0.0.1", "cqlc")
+	integration.Truncate(session, EVENTS)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+	batch := gocql.NewBatch(gocql.UnloggedBatch)
+
+	sensors := 50
+
+	for i := 0; i < sensors; i++ {
+		ctx.Upsert(EVENTS).
+			SetInt64(EVENTS.SENSOR, int64(i)).
+			SetTimeUUID(EVENTS.TIMESTAMP, gocql.TimeUUID()).
+			SetFloat32(EVENTS.TEMPERATURE, 19.8).
+			SetInt32(EVENTS.PRESSURE, 357).
+			Batch(batch)
+	}
+
+	if err := session.ExecuteBatch(batch); err != nil {
+		log.Fatalf("Could not execute batch: %v", err)
+		os.Exit(1)
+	}
+
+	lower := countEvents(session, cqlc.Token(EVENTS.SENSOR).Le(0))
+	upper := countEvents(session, cqlc.Token(EVENTS.SENSOR).Gt(0))
+
+	if lower+upper == sensors {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("Expected %d events; got %d + %d", sensors, lower, upper)
+	}
+
+	os.Stdout.WriteString(result)
+}
+
+func countEvents(session *gocql.Session, cond cqlc.Condition) int {
+
+	ctx := cqlc.NewContext()
+	iter, err := ctx.Select().From(EVENTS).Where(cond).Fetch(session)
+	if err != nil {
+		log.Fatalf("Could not prepare query: %v", err)
+		os.Exit(1)
+	}
+
+	count := 0
+
+	MapEvents(iter, func(e Events) (bool, error) {
+		count++
+		return true, nil
+	})
+
+	if err := iter.Close(); err != nil {
+		log.Fatalf("Could not close iterator: %v", err)
+		os.Exit(1)
+	}
+
+	return count
+}
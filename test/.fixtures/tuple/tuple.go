@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"github.com/gocql/gocql"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+)
+
+var TABLE = CLUSTER_BY_STRING_AND_INT
+
+func main() {
+	session := integration.TestSession("127.0.0.1", "cqlc")
+	integration.Truncate(session, TABLE)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+
+	for _, s := range []string{"x", "y"} {
+		for i := int64(1); i <= 2; i++ {
+			err := ctx.Upsert(TABLE).
+				SetString(TABLE.ID, "a").
+				SetString(TABLE.STRING_CLUSTER, s).
+				SetInt64(TABLE.INT64_CLUSTER, i).
+				SetInt64(TABLE.LAST_CLUSTER_ELEMENT, i*10).
+				SetInt32(TABLE.INT32_COLUMN, 100).
+				Exec(session)
+
+			if err != nil {
+				log.Fatalf("Could not execute upsert: %v", err)
+				os.Exit(1)
+			}
+		}
+	}
+
+	id := TABLE.ID.Eq("a")
+	tuple := cqlc.Tuple(TABLE.STRING_CLUSTER, TABLE.INT64_CLUSTER)
+
+	gt := count(session, id, tuple.Gt("x", int64(1)))
+	in := count(session, id, tuple.In([]interface{}{"x", int64(2)}, []interface{}{"y", int64(1)}))
+
+	if gt == 3 && in == 2 {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("Expected 3 and 2 rows; got %d and %d", gt, in)
+	}
+
+	os.Stdout.WriteString(result)
+}
+
+func count(s *gocql.Session, conds ...cqlc.Condition) int {
+
+	ctx := cqlc.NewContext()
+	iter, err := ctx.Select().From(TABLE).Where(conds...).Fetch(s)
+	if err != nil {
+		log.Fatalf("Could not prepare query: %v", err)
+		os.Exit(1)
+	}
+
+	rows, err := BindClusterByStringAndInt(iter)
+	if err != nil {
+		log.Fatalf("Could not bind data: %v", err)
+		os.Exit(1)
+	}
+
+	if err := iter.Close(); err != nil {
+		log.Fatalf("Could not close iterator: %v", err)
+		os.Exit(1)
+	}
+
+	return len(rows)
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"os"
+)
+
+func main() {
+
+	session := integration.TestSession("127.0.0.1", "cqlc")
+
+	result := "FAILED"
+
+	err := cqlc.ValidateSchema(session, BASIC, BASIC_COUNTER, CLUSTER_BY_STRING_AND_INT, SIMPLE_INDEXED_COMPOSITE, SENSOR_READINGS)
+
+	if err == nil {
+		err = cqlc.ValidateKeyspaceSchema(session, "cqlc", BASIC)
+	}
+
+	if err == nil {
+		result = "PASSED"
+	} else {
+		result = err.Error()
+	}
+
+	os.Stdout.WriteString(result)
+}
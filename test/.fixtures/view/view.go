@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"github.com/relops/cqlc/cqlc"
+	"github.com/relops/cqlc/integration"
+	"log"
+	"os"
+)
+
+func main() {
+
+	session := integration.TestSession("127.0.0.1", "cqlc")
+	integration.Truncate(session, ORDERS)
+
+	result := "FAILED"
+
+	ctx := cqlc.NewContext()
+
+	orders := []Orders{
+		Orders{Id: "a", Customer: "alice", Total: 10},
+		Orders{Id: "b", Customer: "bob", Total: 20},
+		Orders{Id: "c", Customer: "alice", Total: 30},
+	}
+
+	for _, order := range orders {
+		if err := ctx.Store(ORDERS.Bind(order)).Exec(session); err != nil {
+			log.Fatalf("Could not store order: %v", err)
+			os.Exit(1)
+		}
+	}
+
+	iter, err := ctx.Select().
+		From(ORDERS_BY_CUSTOMER).
+		Where(ORDERS_BY_CUSTOMER.CUSTOMER.Eq("alice")).
+		Fetch(session)
+
+	if err != nil {
+		log.Fatalf("Could not query view: %v", err)
+		os.Exit(1)
+	}
+
+	ids := make([]string, 0)
+	total := int32(0)
+
+	err = MapOrdersByCustomer(iter, func(o OrdersByCustomer) (bool, error) {
+		ids = append(ids, o.Id)
+		total += o.Total
+		return true, nil
+	})
+
+	if err != nil {
+		log.Fatalf("Could not map view: %v", err)
+		os.Exit(1)
+	}
+
+	if err := iter.Close(); err != nil {
+		log.Fatalf("Could not close iterator: %v", err)
+		os.Exit(1)
+	}
+
+	err = ctx.Delete().From(ORDERS_BY_CUSTOMER).Where(ORDERS_BY_CUSTOMER.CUSTOMER.Eq("alice")).Exec(session)
+
+	if len(ids) == 2 && ids[0] == "a" && ids[1] == "c" && total == 40 && err == cqlc.ErrReadOnlyView {
+		result = "PASSED"
+	} else {
+		result = fmt.Sprintf("Expected orders a and c with a total of 40 and a read-only view; got %v, %d and %v", ids, total, err)
+	}
+
+	os.Stdout.WriteString(result)
+}